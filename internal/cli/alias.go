@@ -0,0 +1,120 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/brandonbloom/wt/internal/config"
+	"github.com/brandonbloom/wt/internal/project"
+	"github.com/spf13/cobra"
+)
+
+// expandAliases rewrites args when the command word names an [aliases] entry
+// in the project's config, following chains of aliases. Real commands always
+// win over an alias of the same name. Any failure to find or read the config
+// leaves args untouched so the command itself reports it.
+func expandAliases(root *cobra.Command, args []string, stderr io.Writer) ([]string, error) {
+	idx, dir, ok := aliasCommandIndex(args)
+	if !ok {
+		return args, nil
+	}
+	projRoot, err := project.LocateRoot(dir)
+	if err != nil {
+		return args, nil
+	}
+	cfg, err := config.Read(filepath.Join(projRoot, ".wt", "config.toml"))
+	if err != nil || len(cfg.Aliases) == 0 {
+		return args, nil
+	}
+
+	builtins := builtinCommandNames(root)
+	var chain []string
+	for {
+		name := args[idx]
+		expansion, isAlias := cfg.AliasArgs(name)
+		if builtins[name] {
+			if isAlias && len(chain) == 0 {
+				fmt.Fprintf(stderr, "warning: alias %q shadows the built-in wt %s command; ignoring it\n", name, name)
+			}
+			return args, nil
+		}
+		if !isAlias {
+			return args, nil
+		}
+		for _, seen := range chain {
+			if seen == name {
+				return nil, fmt.Errorf("alias %s is recursive (%s -> %s)", chain[0], strings.Join(chain, " -> "), name)
+			}
+		}
+		chain = append(chain, name)
+		expanded := make([]string, 0, len(args)+len(expansion)-1)
+		expanded = append(expanded, args[:idx]...)
+		expanded = append(expanded, expansion...)
+		expanded = append(expanded, args[idx+1:]...)
+		args = expanded
+	}
+}
+
+// aliasCommandIndex finds the command word in args, skipping the global
+// -C/--project/--trace flags, and returns the directory whose project config
+// applies, mirroring how applyPreRunFlags resolves those flags. Any other flag
+// before the command word means there is nothing to expand.
+func aliasCommandIndex(args []string) (idx int, dir string, ok bool) {
+	wd, err := os.Getwd()
+	if err != nil {
+		return 0, "", false
+	}
+	projectDir := ""
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if !strings.HasPrefix(arg, "-") {
+			if projectDir != "" {
+				return i, projectDir, true
+			}
+			return i, wd, true
+		}
+		name, value, hasValue := strings.Cut(arg, "=")
+		switch {
+		case name == "-C" || name == "--directory" || name == "--project" || name == "--trace":
+		case strings.HasPrefix(arg, "-C"):
+			name, value, hasValue = "-C", arg[len("-C"):], true
+		default:
+			return 0, "", false
+		}
+		if !hasValue {
+			if i+1 >= len(args) {
+				return 0, "", false
+			}
+			i++
+			value = args[i]
+		}
+		switch name {
+		case "-C", "--directory":
+			if !filepath.IsAbs(value) {
+				value = filepath.Join(wd, value)
+			}
+			wd = value
+		case "--project":
+			if !filepath.IsAbs(value) {
+				value = filepath.Join(wd, value)
+			}
+			projectDir = value
+		}
+	}
+	return 0, "", false
+}
+
+func builtinCommandNames(root *cobra.Command) map[string]bool {
+	// help and completion are added lazily by cobra during Execute.
+	names := map[string]bool{"help": true, "completion": true}
+	for _, cmd := range root.Commands() {
+		names[cmd.Name()] = true
+		for _, alias := range cmd.Aliases {
+			names[alias] = true
+		}
+	}
+	return names
+}
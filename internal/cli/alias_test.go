@@ -0,0 +1,34 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestAliasCommandIndexSkipsGlobalFlags(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		args    []string
+		wantIdx int
+		wantDir string
+		wantOK  bool
+	}{
+		{args: []string{"s", "x"}, wantIdx: 0, wantDir: wd, wantOK: true},
+		{args: []string{"-C", "sub", "s"}, wantIdx: 2, wantDir: filepath.Join(wd, "sub"), wantOK: true},
+		{args: []string{"-Csub", "-C", "/abs", "s"}, wantIdx: 3, wantDir: "/abs", wantOK: true},
+		{args: []string{"--project=/proj", "--trace", "out", "s"}, wantIdx: 3, wantDir: "/proj", wantOK: true},
+		{args: []string{"--version"}, wantOK: false},
+		{args: []string{"-C"}, wantOK: false},
+		{args: nil, wantOK: false},
+	}
+	for _, tc := range cases {
+		idx, dir, ok := aliasCommandIndex(tc.args)
+		if ok != tc.wantOK || (ok && (idx != tc.wantIdx || dir != tc.wantDir)) {
+			t.Errorf("aliasCommandIndex(%q) = %d, %q, %t; want %d, %q, %t", tc.args, idx, dir, ok, tc.wantIdx, tc.wantDir, tc.wantOK)
+		}
+	}
+}
@@ -1,12 +1,15 @@
 package cli
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
 
+	"github.com/brandonbloom/wt/internal/project"
 	"github.com/spf13/cobra"
 )
 
@@ -20,6 +23,8 @@ func newBootstrapCommand() *cobra.Command {
 	cmd.Flags().Bool("strict", false, "force strict mode (set -euo pipefail) for the bootstrap script")
 	cmd.Flags().Bool("no-strict", false, "disable strict mode even if enabled in config")
 	cmd.Flags().BoolP("xtrace", "x", false, "print each bootstrap command as it runs (set -x)")
+	cmd.Flags().BoolP("all", "a", false, "run the bootstrap script in every non-default worktree")
+	cmd.Flags().Bool("include-default", false, "with --all, also run it in the default worktree")
 	return cmd
 }
 
@@ -35,17 +40,19 @@ func runBootstrapCmd(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	wd, err := os.Getwd()
+	flags := cmd.Flags()
+	all, err := flags.GetBool("all")
 	if err != nil {
 		return err
 	}
-
-	worktreeRoot, err := locateWorktreeRoot(wd, proj.Root)
+	includeDefault, err := flags.GetBool("include-default")
 	if err != nil {
 		return err
 	}
+	if includeDefault && !all {
+		return errors.New("--include-default requires --all")
+	}
 
-	flags := cmd.Flags()
 	strict := proj.Config.Bootstrap.StrictEnabled()
 	if flags.Changed("strict") && flags.Changed("no-strict") {
 		return fmt.Errorf("cannot use --strict and --no-strict together")
@@ -60,16 +67,161 @@ func runBootstrapCmd(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	if err := runBootstrap(cmd, script, worktreeRoot, bootstrapOptions{
-		strict: strict,
-		xtrace: xtrace,
-	}); err != nil {
+	opts := bootstrapOptions{
+		strict:  strict,
+		xtrace:  xtrace,
+		timeout: proj.Config.Bootstrap.TimeoutDuration(),
+		env:     proj.Config.Bootstrap.Env,
+	}
+
+	if all {
+		return runBootstrapAll(cmd, proj, script, opts, includeDefault)
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+
+	worktreeRoot, err := locateWorktreeRoot(wd, proj.Root)
+	if err != nil {
+		return err
+	}
+
+	if err := runBootstrap(cmd, script, worktreeRoot, opts); err != nil {
 		return err
 	}
 
 	return nil
 }
 
+// runBootstrapAll runs the script in each worktree in turn, prefixing every
+// output line with the worktree name. Failures do not stop the remaining
+// worktrees; they are joined into the returned error.
+func runBootstrapAll(cmd *cobra.Command, proj *project.Project, script string, opts bootstrapOptions, includeDefault bool) error {
+	worktrees, err := project.ListWorktrees(proj.Root)
+	if err != nil {
+		return err
+	}
+	var combined error
+	ran := 0
+	for _, wt := range worktrees {
+		if wt.Name == proj.DefaultWorktree && !includeDefault {
+			continue
+		}
+		ran++
+		prefix := "[" + wt.Name + "] "
+		stdout := &linePrefixWriter{w: cmd.OutOrStdout(), prefix: prefix}
+		stderr := &linePrefixWriter{w: cmd.ErrOrStderr(), prefix: prefix}
+		runOpts := opts
+		runOpts.stdout = stdout
+		runOpts.stderr = stderr
+		err := runBootstrap(cmd, script, wt.Path, runOpts)
+		stdout.Flush()
+		stderr.Flush()
+		if err != nil {
+			combined = errors.Join(combined, fmt.Errorf("%s: %w", wt.Name, err))
+		}
+	}
+	if ran == 0 {
+		fmt.Fprintln(cmd.OutOrStdout(), "No worktrees to bootstrap.")
+	}
+	return combined
+}
+
+// linePrefixWriter prepends prefix to every line written through it. A
+// trailing partial line is held until it is completed or flushed.
+type linePrefixWriter struct {
+	w       io.Writer
+	prefix  string
+	pending []byte
+}
+
+func (p *linePrefixWriter) Write(b []byte) (int, error) {
+	p.pending = append(p.pending, b...)
+	for {
+		idx := bytes.IndexByte(p.pending, '\n')
+		if idx < 0 {
+			break
+		}
+		if _, err := fmt.Fprintf(p.w, "%s%s", p.prefix, p.pending[:idx+1]); err != nil {
+			return 0, err
+		}
+		p.pending = p.pending[idx+1:]
+	}
+	return len(b), nil
+}
+
+// Flush writes any unterminated final line.
+func (p *linePrefixWriter) Flush() {
+	if len(p.pending) > 0 {
+		fmt.Fprintf(p.w, "%s%s\n", p.prefix, p.pending)
+		p.pending = nil
+	}
+}
+
+// bootstrapMarkerName lives in a worktree's private git dir (.git for the
+// default worktree, .git/worktrees/<name> for the rest), so it is never
+// tracked, follows `git worktree move`, and goes away with the worktree.
+const bootstrapMarkerName = "wt-bootstrapped"
+
+// worktreeGitDir resolves dir's private git dir from its .git entry: the
+// directory itself, or the `gitdir:` a linked worktree's .git file points at.
+func worktreeGitDir(dir string) (string, error) {
+	gitPath := filepath.Join(dir, ".git")
+	info, err := os.Stat(gitPath)
+	if err != nil {
+		return "", err
+	}
+	if info.IsDir() {
+		return gitPath, nil
+	}
+	data, err := os.ReadFile(gitPath)
+	if err != nil {
+		return "", err
+	}
+	gitDir, ok := strings.CutPrefix(strings.TrimSpace(string(data)), "gitdir:")
+	if !ok {
+		return "", fmt.Errorf("%s: not a gitdir file", gitPath)
+	}
+	gitDir = strings.TrimSpace(gitDir)
+	if !filepath.IsAbs(gitDir) {
+		gitDir = filepath.Join(dir, gitDir)
+	}
+	return gitDir, nil
+}
+
+// writeBootstrapMarker records that the bootstrap script succeeded in dir.
+// Directories that are not worktrees are left alone.
+func writeBootstrapMarker(dir string) error {
+	gitDir, err := worktreeGitDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(gitDir, bootstrapMarkerName), nil, 0o644)
+}
+
+func clearBootstrapMarker(dir string) {
+	if gitDir, err := worktreeGitDir(dir); err == nil {
+		_ = os.Remove(filepath.Join(gitDir, bootstrapMarkerName))
+	}
+}
+
+// hasBootstrapMarker reports whether the bootstrap script last succeeded in
+// dir. Unreadable git metadata counts as bootstrapped so status does not flag
+// worktrees it cannot inspect anyway.
+func hasBootstrapMarker(dir string) bool {
+	gitDir, err := worktreeGitDir(dir)
+	if err != nil {
+		return true
+	}
+	_, err = os.Stat(filepath.Join(gitDir, bootstrapMarkerName))
+	return err == nil
+}
+
 func locateWorktreeRoot(start, projectRoot string) (string, error) {
 	cur, err := filepath.Abs(start)
 	if err != nil {
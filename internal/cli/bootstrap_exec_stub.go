@@ -0,0 +1,12 @@
+//go:build windows
+
+package cli
+
+import (
+	"os/exec"
+	"time"
+)
+
+func configureBootstrapProcessGroup(run *exec.Cmd) {
+	run.WaitDelay = 2 * time.Second
+}
@@ -0,0 +1,20 @@
+//go:build !windows
+
+package cli
+
+import (
+	"os/exec"
+	"syscall"
+	"time"
+)
+
+func configureBootstrapProcessGroup(run *exec.Cmd) {
+	run.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	run.Cancel = func() error {
+		if run.Process == nil {
+			return nil
+		}
+		return syscall.Kill(-run.Process.Pid, syscall.SIGKILL)
+	}
+	run.WaitDelay = 2 * time.Second
+}
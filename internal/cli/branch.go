@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/brandonbloom/wt/internal/gitutil"
+	"github.com/brandonbloom/wt/internal/project"
+	"github.com/brandonbloom/wt/internal/shellbridge"
+	"github.com/spf13/cobra"
+)
+
+func newBranchCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "branch <branch>",
+		Short: "Create a worktree for an existing local branch",
+		Args:  cobra.ExactArgs(1),
+		RunE:  runBranch,
+	}
+	return cmd
+}
+
+func runBranch(cmd *cobra.Command, args []string) error {
+	proj, err := loadProjectFromWD()
+	if err != nil {
+		return err
+	}
+
+	branch := strings.TrimSpace(args[0])
+	if branch == "" {
+		return errors.New("branch name required")
+	}
+	if !gitutil.BranchExists(proj.DefaultWorktreePath, branch) {
+		return fmt.Errorf("no local branch %s (use `wt new %s` to create one)", branch, branch)
+	}
+	targetPath, err := prepareBranchWorktree(proj, branch)
+	if err != nil {
+		return err
+	}
+	return openBranchWorktree(cmd, proj, branch, targetPath, "Created")
+}
+
+// prepareBranchWorktree validates that branch can get its own worktree and
+// returns the directory it should live in.
+func prepareBranchWorktree(proj *project.Project, branch string) (string, error) {
+	if branch == proj.Config.DefaultBranch {
+		return "", fmt.Errorf("%s is the default branch; it already lives in %s", branch, proj.DefaultWorktree)
+	}
+	if path, ok, err := gitutil.BranchWorktreePath(proj.DefaultWorktreePath, branch); err != nil {
+		return "", err
+	} else if ok {
+		return "", fmt.Errorf("branch %s is already checked out at %s", branch, path)
+	}
+
+	name := worktreeNameFromBranch(branch)
+	if name == "" || name == "main" || name == "master" {
+		return "", fmt.Errorf("cannot derive a worktree directory name from branch %q", branch)
+	}
+	targetPath := filepath.Join(proj.Root, name)
+	if _, err := os.Stat(targetPath); err == nil {
+		return "", fmt.Errorf("worktree %s already exists", name)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return "", err
+	}
+	return targetPath, nil
+}
+
+// openBranchWorktree checks out an existing branch into targetPath, then
+// bootstraps and enters it just like wt new.
+func openBranchWorktree(cmd *cobra.Command, proj *project.Project, branch, targetPath, verb string) error {
+	gitCmd := exec.Command("git", "-C", proj.DefaultWorktreePath, "worktree", "add", targetPath, branch)
+	gitCmd.Stdout = cmd.OutOrStdout()
+	gitCmd.Stderr = cmd.ErrOrStderr()
+	gitCmd.Stdin = os.Stdin
+	if err := gitCmd.Run(); err != nil {
+		return fmt.Errorf("git worktree add failed: %w", err)
+	}
+
+	if err := runBootstrap(cmd, proj.Config.Bootstrap.Run, targetPath, bootstrapOptions{
+		strict:  proj.Config.Bootstrap.StrictEnabled(),
+		timeout: proj.Config.Bootstrap.TimeoutDuration(),
+		env:     proj.Config.Bootstrap.Env,
+	}); err != nil {
+		return err
+	}
+
+	if err := shellbridge.ChangeDirectory(targetPath); err != nil {
+		fmt.Fprintf(cmd.OutOrStdout(), "%s %s at %s (run `cd %s`)\n", verb, branch, targetPath, targetPath)
+	} else {
+		fmt.Fprintf(cmd.OutOrStdout(), "%s %s at %s\n", verb, branch, targetPath)
+	}
+	return nil
+}
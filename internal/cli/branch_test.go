@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/brandonbloom/wt/internal/gitutil"
+	"github.com/brandonbloom/wt/internal/project"
+)
+
+func TestBranchWorktreePathFindsCheckedOutBranch(t *testing.T) {
+	repo := initTempRepo(t)
+	worktreePath := filepath.Join(repo, "feature")
+	gitCmd(t, repo, "worktree", "add", "-b", "feature", worktreePath)
+	gitCmd(t, repo, "branch", "idle")
+
+	path, ok, err := gitutil.BranchWorktreePath(repo, "feature")
+	if err != nil || !ok {
+		t.Fatalf("BranchWorktreePath(feature) = %q, %t, %v", path, ok, err)
+	}
+	if canonicalizePath(path) != canonicalizePath(worktreePath) {
+		t.Fatalf("path = %q, want %q", path, worktreePath)
+	}
+
+	if _, ok, err := gitutil.BranchWorktreePath(repo, "idle"); err != nil || ok {
+		t.Fatalf("idle branch should not be checked out (ok=%t err=%v)", ok, err)
+	}
+}
+
+func TestFindWorktreeByNameFallsBackToBranch(t *testing.T) {
+	worktrees := []project.Worktree{
+		{Name: "feature-login", Path: "/p/feature-login", Branch: "feature/login"},
+		{Name: "api", Path: "/p/api", Branch: "team/api"},
+		{Name: "team-api", Path: "/p/team-api", Branch: "api"},
+	}
+	if wt := findWorktreeByName(worktrees, "feature/login"); wt == nil || wt.Name != "feature-login" {
+		t.Fatalf("branch lookup = %+v, want feature-login", wt)
+	}
+	if wt := findWorktreeByName(worktrees, "api"); wt == nil || wt.Name != "api" {
+		t.Fatalf("directory name should win over branch, got %+v", wt)
+	}
+	if wt := findWorktreeByName(worktrees, "feature/nope"); wt != nil {
+		t.Fatalf("unexpected match %+v", wt)
+	}
+}
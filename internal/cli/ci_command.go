@@ -0,0 +1,92 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// ciCommandPendingExit is the exit code a [ci].command uses to report that
+// checks are still running. 0 means success; anything else is a failure.
+const ciCommandPendingExit = 2
+
+// fetchCommandCIStatuses fills CI columns by running the configured [ci].command
+// in each worktree instead of asking GitHub, at most GOMAXPROCS at a time.
+// CI scripts can be heavy (builds, test runs), so a project with dozens of
+// worktrees must not launch them all at once.
+func fetchCommandCIStatuses(ctx context.Context, command string, statuses []*worktreeStatus, now time.Time, onUpdate func(*worktreeStatus)) error {
+	sh := os.Getenv("SHELL")
+	if sh == "" {
+		sh = "/bin/sh"
+	}
+
+	parallelism := runtime.GOMAXPROCS(0)
+	if parallelism < 1 {
+		parallelism = 1
+	}
+	sem := make(chan struct{}, parallelism)
+
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	for _, status := range statuses {
+		if status == nil || status.HasError || status.Error != "" {
+			continue
+		}
+		status := status
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			res, err := runCICommand(ctx, sh, command, status)
+			if ctx.Err() != nil {
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				setCIError(status, formatErrorLabel(singleLineError(err)), ciStateError)
+			} else {
+				applyCIResult(status, res, now)
+			}
+			if onUpdate != nil {
+				onUpdate(status)
+			}
+		}()
+	}
+	wg.Wait()
+
+	switch {
+	case errors.Is(ctx.Err(), context.Canceled):
+		markCIInterrupted(statuses, onUpdate)
+		return ctx.Err()
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		markCITimedOut(statuses, onUpdate)
+		return ctx.Err()
+	}
+	return nil
+}
+
+func runCICommand(ctx context.Context, sh, command string, status *worktreeStatus) (ciResult, error) {
+	run := exec.CommandContext(ctx, sh, "-c", command)
+	run.Dir = status.Path
+	run.Env = append(os.Environ(), "WT_BRANCH="+status.Branch, "WT_HEAD="+status.HeadHash)
+	err := run.Run()
+	if err == nil {
+		return ciResult{State: ciStateSuccess}, nil
+	}
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) {
+		return ciResult{}, err
+	}
+	if exitErr.ExitCode() == ciCommandPendingExit {
+		return ciResult{State: ciStatePending}, nil
+	}
+	return ciResult{State: ciStateFailure}, nil
+}
@@ -32,6 +32,8 @@ const (
 const (
 	ciInterruptedLabel   = "CI: interrupted"
 	prInterruptedLabel   = "PR: interrupted"
+	ciTimeoutLabel       = "CI: timeout"
+	prTimeoutLabel       = "PR: timeout"
 	ciMissingCommitMsg   = "unpublished"
 	ciMissingCommitLabel = "CI: ? " + ciMissingCommitMsg
 )
@@ -50,20 +52,33 @@ type ciRunSummary struct {
 type ciResult struct {
 	State   ciState
 	Failure *ciRunSummary
+	// Runs is every run the state was summarized from, whatever its outcome.
+	Runs    []ciRunSummary
 	Message string
+	// MergeRef marks a result read from the pull request's merge ref, which
+	// can disagree with CI on the branch HEAD itself.
+	MergeRef bool
 }
 
 type ciTarget struct {
 	Ref    string
 	Branch string
 	Head   string
+	// MergeRef reports that Ref is refs/pull/<n>/merge rather than Head.
+	MergeRef bool
 }
 
+// ciMergeMarker follows the CI glyph when the result came from the pull
+// request's merge ref.
+const ciMergeMarker = " (merge)"
+
 type ciFetchOptions struct {
-	Repo       *githubRepo
+	Repo       *forgeRepo
 	RepoErr    error
 	RemoteName string
 	Workdir    string
+	// Command, when set, replaces the GitHub lookup with [ci].command.
+	Command string
 }
 
 type ciRequest struct {
@@ -84,6 +99,12 @@ func fetchCIStatuses(ctx context.Context, opts ciFetchOptions, statuses []*workt
 		return nil
 	}
 
+	if opts.Command != "" {
+		region := trace.StartRegion(ctx, "fetch ci (command)")
+		defer region.End()
+		return fetchCommandCIStatuses(ctx, opts.Command, statuses, now, onUpdate)
+	}
+
 	if strings.TrimSpace(os.Getenv("WT_TEST_SERIAL_FETCH")) != "" {
 		serialRegion := trace.StartRegion(ctx, "fetch ci (serial)")
 		defer serialRegion.End()
@@ -109,6 +130,10 @@ func fetchCIStatuses(ctx context.Context, opts ciFetchOptions, statuses []*workt
 				markCIInterrupted(statuses, onUpdate)
 				return err
 			}
+			if errors.Is(err, context.DeadlineExceeded) {
+				markCITimedOut(statuses, onUpdate)
+				return err
+			}
 			if err != nil {
 				combined = errors.Join(combined, err)
 				msg := formatCIErrorLabel(err)
@@ -204,7 +229,7 @@ func fetchCIStatuses(ctx context.Context, opts ciFetchOptions, statuses []*workt
 				trace.Logf(ctx, "ci", "ref=%s branch=%s", req.target.Ref, req.target.Branch)
 				return fetchCITarget(ctx, opts, req.target)
 			}()
-			if errors.Is(err, context.Canceled) {
+			if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
 				return
 			}
 			results <- ciFetchResult{req: req, result: res, err: err}
@@ -252,8 +277,12 @@ func fetchCIStatuses(ctx context.Context, opts ciFetchOptions, statuses []*workt
 			}
 		}
 	}
-	if err := ctx.Err(); errors.Is(err, context.Canceled) {
-		markCIInterrupted(statuses, onUpdate)
+	if err := ctx.Err(); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			markCITimedOut(statuses, onUpdate)
+		} else {
+			markCIInterrupted(statuses, onUpdate)
+		}
 		if combined != nil {
 			return errors.Join(combined, err)
 		}
@@ -269,9 +298,10 @@ func determineCITarget(status *worktreeStatus) (ciTarget, error) {
 	open := openPullRequests(status.PullRequests)
 	if len(open) == 1 {
 		return ciTarget{
-			Ref:    fmt.Sprintf("refs/pull/%d/merge", open[0].Number),
-			Branch: status.Branch,
-			Head:   status.HeadHash,
+			Ref:      fmt.Sprintf("refs/pull/%d/merge", open[0].Number),
+			Branch:   status.Branch,
+			Head:     status.HeadHash,
+			MergeRef: true,
 		}, nil
 	}
 	if status.HeadHash == "" {
@@ -288,6 +318,9 @@ func fetchCITarget(ctx context.Context, opts ciFetchOptions, target ciTarget) (c
 	region := trace.StartRegion(ctx, "fetch ci target")
 	defer region.End()
 
+	if !opts.Repo.usesGh() {
+		return fetchForgeCIRuns(ctx, opts, target)
+	}
 	path := fmt.Sprintf(
 		"repos/%s/commits/%s/check-runs",
 		opts.Repo.slug(),
@@ -305,7 +338,9 @@ func fetchCITarget(ctx context.Context, opts ciFetchOptions, target ciTarget) (c
 		return ciResult{}, err
 	}
 	if len(resp.CheckRuns) > 0 {
-		return summarizeCheckRuns(resp), nil
+		res := summarizeCheckRuns(resp)
+		res.MergeRef = target.MergeRef
+		return res, nil
 	}
 	if target.Branch == "" {
 		return ciResult{State: ciStateUnknown}, nil
@@ -318,6 +353,25 @@ func fetchCITarget(ctx context.Context, opts ciFetchOptions, target ciTarget) (c
 	return fallback, nil
 }
 
+// fetchForgeCIRuns asks a non-GitHub forge for the runs on the target's
+// commit. Forges without CI support report none, which reads as unknown.
+func fetchForgeCIRuns(ctx context.Context, opts ciFetchOptions, target ciTarget) (ciResult, error) {
+	runs, err := opts.Repo.Forge.ListCIRuns(ctx, opts.Workdir, target.Head)
+	if err != nil {
+		return ciResult{}, err
+	}
+	resp := ghCheckRunsResponse{TotalCount: len(runs)}
+	for _, run := range runs {
+		resp.CheckRuns = append(resp.CheckRuns, ghCheckRun{
+			Name:       run.Name,
+			Status:     run.Status,
+			Conclusion: run.Conclusion,
+			HTMLURL:    run.URL,
+		})
+	}
+	return summarizeCheckRuns(resp), nil
+}
+
 func fetchWorkflowFallback(ctx context.Context, opts ciFetchOptions, target ciTarget) (ciResult, error) {
 	region := trace.StartRegion(ctx, "fetch ci workflow fallback")
 	defer region.End()
@@ -371,7 +425,24 @@ func runGhJSON(ctx context.Context, workdir string, args ...string) ([]byte, err
 	return stdout.Bytes(), nil
 }
 
+// ghPhaseContext bounds one phase of gh calls (PR lookups, CI lookups) so a
+// wedged gh cannot hang the command; CommandContext kills the stragglers.
+func ghPhaseContext(parent context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(parent)
+	}
+	return context.WithTimeout(parent, timeout)
+}
+
 func markCIInterrupted(statuses []*worktreeStatus, onUpdate func(*worktreeStatus)) {
+	markCIUnfinished(statuses, ciInterruptedLabel, onUpdate)
+}
+
+func markCITimedOut(statuses []*worktreeStatus, onUpdate func(*worktreeStatus)) {
+	markCIUnfinished(statuses, ciTimeoutLabel, onUpdate)
+}
+
+func markCIUnfinished(statuses []*worktreeStatus, label string, onUpdate func(*worktreeStatus)) {
 	for _, status := range statuses {
 		if status == nil {
 			continue
@@ -379,7 +450,7 @@ func markCIInterrupted(statuses []*worktreeStatus, onUpdate func(*worktreeStatus
 		if strings.TrimSpace(status.CIStatus) != "" {
 			continue
 		}
-		setCIError(status, ciInterruptedLabel, ciStateError)
+		setCIError(status, label, ciStateError)
 		if onUpdate != nil {
 			onUpdate(status)
 		}
@@ -435,6 +506,7 @@ type ghWorkflowRun struct {
 
 func summarizeCheckRuns(resp ghCheckRunsResponse) ciResult {
 	var failure *ciRunSummary
+	runs := make([]ciRunSummary, 0, len(resp.CheckRuns))
 	hasPending := false
 	hasSuccess := false
 	hasWarning := false
@@ -448,6 +520,7 @@ func summarizeCheckRuns(resp ghCheckRunsResponse) ciResult {
 			StartedAt:   parseTime(run.StartedAt),
 			CompletedAt: parseTime(run.CompletedAt),
 		}
+		runs = append(runs, summary)
 		switch summary.Status {
 		case "queued", "in_progress":
 			hasPending = true
@@ -467,20 +540,21 @@ func summarizeCheckRuns(resp ghCheckRunsResponse) ciResult {
 
 	switch {
 	case failure != nil:
-		return ciResult{State: ciStateFailure, Failure: failure}
+		return ciResult{State: ciStateFailure, Failure: failure, Runs: runs}
 	case hasPending:
-		return ciResult{State: ciStatePending}
+		return ciResult{State: ciStatePending, Runs: runs}
 	case hasSuccess:
-		return ciResult{State: ciStateSuccess}
+		return ciResult{State: ciStateSuccess, Runs: runs}
 	case hasWarning:
-		return ciResult{State: ciStateWarning}
+		return ciResult{State: ciStateWarning, Runs: runs}
 	default:
-		return ciResult{State: ciStateUnknown}
+		return ciResult{State: ciStateUnknown, Runs: runs}
 	}
 }
 
 func summarizeWorkflowRuns(runs []ghWorkflowRun, head string) ciResult {
 	var failure *ciRunSummary
+	var matched []ciRunSummary
 	hasPending := false
 	hasSuccess := false
 
@@ -496,6 +570,7 @@ func summarizeWorkflowRuns(runs []ghWorkflowRun, head string) ciResult {
 			StartedAt:   parseTime(run.CreatedAt),
 			CompletedAt: parseTime(run.UpdatedAt),
 		}
+		matched = append(matched, summary)
 		switch summary.Status {
 		case "queued", "in_progress":
 			hasPending = true
@@ -513,13 +588,13 @@ func summarizeWorkflowRuns(runs []ghWorkflowRun, head string) ciResult {
 
 	switch {
 	case failure != nil:
-		return ciResult{State: ciStateFailure, Failure: failure}
+		return ciResult{State: ciStateFailure, Failure: failure, Runs: matched}
 	case hasPending:
-		return ciResult{State: ciStatePending}
+		return ciResult{State: ciStatePending, Runs: matched}
 	case hasSuccess:
-		return ciResult{State: ciStateSuccess}
+		return ciResult{State: ciStateSuccess, Runs: matched}
 	default:
-		return ciResult{State: ciStateUnknown}
+		return ciResult{State: ciStateUnknown, Runs: matched}
 	}
 }
 
@@ -530,6 +605,8 @@ func setCIError(status *worktreeStatus, label string, state ciState) {
 	status.CIStatus = label
 	status.CIState = state
 	status.CIDetail = nil
+	status.CIRuns = nil
+	status.CIMergeRef = false
 }
 
 func applyCIResult(status *worktreeStatus, res ciResult, now time.Time) {
@@ -538,6 +615,8 @@ func applyCIResult(status *worktreeStatus, res ciResult, now time.Time) {
 	}
 	status.CIState = res.State
 	status.CIDetail = status.CIDetail[:0]
+	status.CIRuns = append([]ciRunSummary(nil), res.Runs...)
+	status.CIMergeRef = false
 	switch res.State {
 	case ciStateFailure:
 		if res.Failure != nil {
@@ -545,11 +624,11 @@ func applyCIResult(status *worktreeStatus, res ciResult, now time.Time) {
 		}
 		status.CIStatus = formatCILabel(res, now)
 	case ciStatePending:
-		status.CIStatus = "CI◷"
+		status.CIStatus = "CI◷" + ciMergeSuffix(res)
 	case ciStateSuccess:
-		status.CIStatus = "CI✓"
+		status.CIStatus = "CI✓" + ciMergeSuffix(res)
 	case ciStateWarning:
-		status.CIStatus = "CI!"
+		status.CIStatus = "CI!" + ciMergeSuffix(res)
 	case ciStateError:
 		status.CIStatus = formatErrorLabel(res.Message)
 	case ciStateUnknown:
@@ -561,19 +640,30 @@ func applyCIResult(status *worktreeStatus, res ciResult, now time.Time) {
 	default:
 		status.CIStatus = "CI?"
 	}
+	switch res.State {
+	case ciStateFailure, ciStatePending, ciStateSuccess, ciStateWarning:
+		status.CIMergeRef = res.MergeRef
+	}
+}
+
+func ciMergeSuffix(res ciResult) string {
+	if res.MergeRef {
+		return ciMergeMarker
+	}
+	return ""
 }
 
 func formatCILabel(res ciResult, now time.Time) string {
 	if res.State != ciStateFailure || res.Failure == nil {
 		if res.State == ciStateFailure {
-			return "CI✗"
+			return "CI✗" + ciMergeSuffix(res)
 		}
 		return formatErrorLabel(res.Message)
 	}
-	label := "CI✗"
+	label := "CI✗" + ciMergeSuffix(res)
 	name := strings.TrimSpace(res.Failure.Name)
 	if name != "" {
-		label = fmt.Sprintf("CI✗ %s", name)
+		label = fmt.Sprintf("%s %s", label, name)
 	}
 	if !res.Failure.CompletedAt.IsZero() {
 		label = fmt.Sprintf("%s (%s)", label, timefmt.Relative(res.Failure.CompletedAt, now))
@@ -45,6 +45,47 @@ func TestClassifyGhErrorDetectsMissingCommit(t *testing.T) {
 	}
 }
 
+func TestApplyCIResultMarksMergeRef(t *testing.T) {
+	now := time.Date(2000, 1, 3, 12, 0, 0, 0, time.UTC)
+	status := &worktreeStatus{Name: "feature"}
+	applyCIResult(status, ciResult{State: ciStateSuccess, MergeRef: true}, now)
+	if status.CIStatus != "CI✓ (merge)" || !status.CIMergeRef {
+		t.Fatalf("merge ref success = %q (merge ref %v), want CI✓ (merge)", status.CIStatus, status.CIMergeRef)
+	}
+
+	failure := &ciRunSummary{Name: "build", CompletedAt: now.Add(-5 * time.Minute)}
+	applyCIResult(status, ciResult{State: ciStateFailure, Failure: failure, MergeRef: true}, now)
+	if want := "CI✗ (merge) build (5 min ago)"; status.CIStatus != want {
+		t.Fatalf("merge ref failure = %q, want %q", status.CIStatus, want)
+	}
+
+	applyCIResult(status, ciResult{State: ciStateSuccess}, now)
+	if status.CIStatus != "CI✓" || status.CIMergeRef {
+		t.Fatalf("head success = %q (merge ref %v), want plain CI✓", status.CIStatus, status.CIMergeRef)
+	}
+}
+
+func TestApplyCIResultKeepsEveryRun(t *testing.T) {
+	now := time.Date(2000, 1, 3, 12, 0, 0, 0, time.UTC)
+	res := summarizeCheckRuns(ghCheckRunsResponse{CheckRuns: []ghCheckRun{
+		{Name: "lint", Status: "completed", Conclusion: "success"},
+		{Name: "deploy", Status: "in_progress"},
+	}})
+	status := &worktreeStatus{Name: "feature"}
+	applyCIResult(status, res, now)
+	if status.CIState != ciStatePending || len(status.CIDetail) != 0 {
+		t.Fatalf("state = %v, detail = %+v, want pending without a failure", status.CIState, status.CIDetail)
+	}
+	if len(status.CIRuns) != 2 || status.CIRuns[0].Conclusion != "success" || status.CIRuns[1].Status != "in_progress" {
+		t.Fatalf("runs = %+v, want the passing and pending runs", status.CIRuns)
+	}
+
+	setCIError(status, "CI: ? boom", ciStateError)
+	if status.CIRuns != nil {
+		t.Fatalf("runs = %+v after an error, want none", status.CIRuns)
+	}
+}
+
 func TestFetchCIStatuses_SkipsStatusesWithErrors(t *testing.T) {
 	statuses := []*worktreeStatus{
 		{Name: "ok"},
@@ -66,3 +107,30 @@ func TestFetchCIStatuses_SkipsStatusesWithErrors(t *testing.T) {
 		t.Fatalf("expected broken status PRStatus unchanged, got %q", statuses[1].PRStatus)
 	}
 }
+
+func TestFetchCIStatusesRunsConfiguredCommand(t *testing.T) {
+	t.Setenv("SHELL", "/bin/sh")
+	statuses := []*worktreeStatus{
+		{Name: "green", Path: t.TempDir(), Branch: "green"},
+		{Name: "pending", Path: t.TempDir(), Branch: "pending"},
+		{Name: "red", Path: t.TempDir(), Branch: "red", HeadHash: "abc123"},
+	}
+	command := `case "$WT_BRANCH" in green) exit 0;; pending) exit 2;; *) test "$WT_HEAD" = abc123 && exit 1;; esac; exit 9`
+	opts := ciFetchOptions{Command: command}
+	if err := fetchCIStatuses(context.Background(), opts, statuses, time.Now(), nil); err != nil {
+		t.Fatalf("fetchCIStatuses: %v", err)
+	}
+	want := []struct {
+		state ciState
+		label string
+	}{
+		{ciStateSuccess, "CI✓"},
+		{ciStatePending, "CI◷"},
+		{ciStateFailure, "CI✗"},
+	}
+	for i, status := range statuses {
+		if status.CIState != want[i].state || status.CIStatus != want[i].label {
+			t.Fatalf("%s: got (%v, %q), want (%v, %q)", status.Name, status.CIState, status.CIStatus, want[i].state, want[i].label)
+		}
+	}
+}
@@ -8,20 +8,29 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/brandonbloom/wt/internal/project"
 	"github.com/spf13/cobra"
 )
 
+type cloneOptions struct {
+	branch string
+}
+
 func newCloneCommand() *cobra.Command {
+	opts := &cloneOptions{}
 	cmd := &cobra.Command{
 		Use:   "clone <url> [<dest>]",
 		Short: "Clone a repository and automatically initialize wt",
 		Args:  cobra.RangeArgs(1, 2),
-		RunE:  runClone,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runClone(cmd, opts, args)
+		},
 	}
+	cmd.Flags().StringVarP(&opts.branch, "branch", "b", "", "also create a worktree for this branch and start there")
 	return cmd
 }
 
-func runClone(cmd *cobra.Command, args []string) error {
+func runClone(cmd *cobra.Command, opts *cloneOptions, args []string) error {
 	url := args[0]
 	var dest string
 	if len(args) == 2 {
@@ -48,7 +57,28 @@ func runClone(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
-	return initializeInDirectory(cmd, absTarget)
+	if err := initializeInDirectory(cmd, absTarget); err != nil {
+		return err
+	}
+
+	branch := strings.TrimSpace(opts.branch)
+	if branch == "" {
+		return nil
+	}
+	// The clone checked out the remote's default branch, which now lives in
+	// the default worktree; any other starting branch gets its own worktree.
+	proj, err := project.Load(absTarget)
+	if err != nil {
+		return err
+	}
+	if branch == proj.Config.DefaultBranch {
+		return nil
+	}
+	targetPath, err := prepareBranchWorktree(proj, branch)
+	if err != nil {
+		return err
+	}
+	return openBranchWorktree(cmd, proj, branch, targetPath, "Checked out")
 }
 
 func deriveCloneDir(url string) string {
@@ -0,0 +1,94 @@
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/brandonbloom/wt/internal/config"
+	"github.com/spf13/cobra"
+)
+
+func newConfigCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Work with the project's .wt/config.toml",
+		Args:  cobra.NoArgs,
+	}
+	cmd.AddCommand(newConfigEditCommand())
+	return cmd
+}
+
+func newConfigEditCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "edit",
+		Short: "Open .wt/config.toml in $VISUAL or $EDITOR and validate it on save",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runConfigEdit(cmd)
+		},
+	}
+}
+
+// runConfigEdit locates the config without loading it, so a config that no
+// longer validates can still be repaired by hand.
+func runConfigEdit(cmd *cobra.Command) error {
+	_, path, err := locateConfigFromWD()
+	if err != nil {
+		return err
+	}
+	editor := configEditor()
+	in := bufio.NewReader(cmd.InOrStdin())
+	out := cmd.OutOrStdout()
+	for {
+		if err := runEditor(cmd, editor, path); err != nil {
+			return err
+		}
+		_, loadErr := config.Load(path)
+		if loadErr == nil {
+			fmt.Fprintf(out, "%s is valid\n", path)
+			return nil
+		}
+		fmt.Fprintf(cmd.ErrOrStderr(), "error: %s\n", singleLineError(loadErr))
+		fmt.Fprint(out, "Re-open the editor? [Y/n]: ")
+		resp, err := in.ReadString('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+		fmt.Fprintln(out)
+		resp = strings.TrimSpace(strings.ToLower(resp))
+		// A bare Enter takes the default; EOF without an answer declines so
+		// a non-interactive run cannot loop forever.
+		reopen := resp == "y" || resp == "yes" || (resp == "" && err == nil)
+		if !reopen {
+			return fmt.Errorf("%s is still invalid", path)
+		}
+	}
+}
+
+// configEditor follows git's lookup order for the editor command.
+func configEditor() string {
+	for _, key := range []string{"VISUAL", "EDITOR"} {
+		if editor := strings.TrimSpace(os.Getenv(key)); editor != "" {
+			return editor
+		}
+	}
+	return "vi"
+}
+
+// runEditor runs editor through the shell so values like "code --wait" work,
+// passing path as a separate argument to avoid quoting issues.
+func runEditor(cmd *cobra.Command, editor, path string) error {
+	c := exec.CommandContext(cmd.Context(), "sh", "-c", editor+` "$@"`, editor, path)
+	c.Stdin = os.Stdin
+	c.Stdout = os.Stdout
+	c.Stderr = os.Stderr
+	if err := c.Run(); err != nil {
+		return fmt.Errorf("editor %q failed: %w", editor, err)
+	}
+	return nil
+}
@@ -2,14 +2,48 @@ package cli
 
 import (
 	"os"
+	"path/filepath"
 
 	"github.com/brandonbloom/wt/internal/project"
 )
 
+// projectDirOverride is the absolute path given to the global --project flag.
+// When set, project discovery starts there instead of the working directory.
+var projectDirOverride string
+
 func loadProjectFromWD() (*project.Project, error) {
+	if projectDirOverride != "" {
+		return project.Discover(projectDirOverride)
+	}
 	wd, err := os.Getwd()
 	if err != nil {
 		return nil, err
 	}
 	return project.Discover(wd)
 }
+
+// locateConfigFromWD finds the project root and its .wt/config.toml without
+// loading the config, so commands can inspect or fix a config that does not
+// validate.
+func locateConfigFromWD() (root, path string, err error) {
+	start := projectDirOverride
+	if start == "" {
+		if start, err = os.Getwd(); err != nil {
+			return "", "", err
+		}
+	}
+	root, err = project.LocateRoot(start)
+	if err != nil {
+		return "", "", err
+	}
+	return root, filepath.Join(root, ".wt", "config.toml"), nil
+}
+
+// targetBaseDir is the directory relative worktree path arguments resolve
+// against: the project root under --project, otherwise the working directory.
+func targetBaseDir(proj *project.Project, wd string) string {
+	if projectDirOverride != "" {
+		return proj.Root
+	}
+	return wd
+}
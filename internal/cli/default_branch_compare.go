@@ -8,9 +8,11 @@ import (
 )
 
 type defaultBranchCompareContext struct {
-	CompareRef    string
-	PRsExpected   bool
-	SyncMode      gitutil.DefaultBranchSyncMode
+	CompareRef  string
+	PRsExpected bool
+	SyncMode    gitutil.DefaultBranchSyncMode
+	// DefaultBranch is the branch being compared against. For
+	// mergeTargetComparisonContext that is integration_branch when set.
 	DefaultBranch string
 }
 
@@ -18,23 +20,40 @@ func defaultBranchComparisonContext(proj *project.Project) defaultBranchCompareC
 	if proj == nil {
 		return defaultBranchCompareContext{}
 	}
+	return branchComparisonContext(proj, proj.Config.DefaultBranch)
+}
+
+// mergeTargetComparisonContext compares against the branch features merge
+// into: integration_branch when configured (and againstDefault is false),
+// otherwise default_branch.
+func mergeTargetComparisonContext(proj *project.Project, againstDefault bool) defaultBranchCompareContext {
+	if proj == nil {
+		return defaultBranchCompareContext{}
+	}
+	if againstDefault {
+		return branchComparisonContext(proj, proj.Config.DefaultBranch)
+	}
+	return branchComparisonContext(proj, proj.Config.MergeTarget())
+}
+
+func branchComparisonContext(proj *project.Project, branch string) defaultBranchCompareContext {
 	workdir := proj.DefaultWorktreePath
 	if workdir == "" && proj.Root != "" && proj.DefaultWorktree != "" {
 		workdir = filepath.Join(proj.Root, proj.DefaultWorktree)
 	}
-	ref, mode, err := gitutil.DefaultBranchComparisonRef(workdir, "origin", proj.Config.DefaultBranch)
+	ref, mode, err := gitutil.DefaultBranchComparisonRef(workdir, "origin", branch)
 	if err != nil {
 		return defaultBranchCompareContext{
-			CompareRef:    proj.Config.DefaultBranch,
+			CompareRef:    branch,
 			PRsExpected:   false,
 			SyncMode:      gitutil.DefaultBranchLocalFirst,
-			DefaultBranch: proj.Config.DefaultBranch,
+			DefaultBranch: branch,
 		}
 	}
 	return defaultBranchCompareContext{
 		CompareRef:    ref,
 		PRsExpected:   mode == gitutil.DefaultBranchRemoteFirst,
 		SyncMode:      mode,
-		DefaultBranch: proj.Config.DefaultBranch,
+		DefaultBranch: branch,
 	}
 }
@@ -2,12 +2,15 @@ package cli
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"os"
 	"os/exec"
+	"strings"
 
+	"github.com/brandonbloom/wt/internal/config"
 	"github.com/brandonbloom/wt/internal/processes"
 	"github.com/brandonbloom/wt/internal/project"
 	"github.com/brandonbloom/wt/internal/shellbridge"
@@ -15,15 +18,16 @@ import (
 )
 
 func newDoctorCommand() *cobra.Command {
-	var verbose bool
+	var verbose, fix bool
 	cmd := &cobra.Command{
 		Use:   "doctor",
 		Short: "Diagnose wt prerequisites and environment issues",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runDoctor(cmd, verbose)
+			return runDoctor(cmd, verbose, fix)
 		},
 	}
 	cmd.Flags().BoolVarP(&verbose, "verbose", "v", false, "show passing checks too")
+	cmd.Flags().BoolVar(&fix, "fix", false, "rewrite .wt/config.toml with invalid settings reset to their defaults")
 	return cmd
 }
 
@@ -36,22 +40,50 @@ type doctorCheck struct {
 	Fn   func(*doctorContext) error
 }
 
-func runDoctor(cmd *cobra.Command, verbose bool) error {
+// doctorWarning is reported by a check that found something worth fixing but
+// that does not break wt; it does not count as a failure.
+type doctorWarning string
+
+func (w doctorWarning) Error() string { return string(w) }
+
+// doctorSkipped is reported by a check that does not apply, such as the gh
+// checks when GitHub access is disabled; it does not count as a failure.
+type doctorSkipped string
+
+func (s doctorSkipped) Error() string { return string(s) }
+
+// unlessGhDisabled skips fn when WT_NO_GH or [gh].enabled = false turns gh
+// off. The project may not be loaded yet, so its config is read on demand.
+func unlessGhDisabled(fn func(*doctorContext) error) func(*doctorContext) error {
+	return func(c *doctorContext) error {
+		proj := c.Project
+		if proj == nil {
+			proj, _ = loadProjectFromWD()
+		}
+		if ghDisabled(proj) {
+			return doctorSkipped("GitHub access disabled (WT_NO_GH or [gh].enabled = false)")
+		}
+		return fn(c)
+	}
+}
+
+func runDoctor(cmd *cobra.Command, verbose, fix bool) error {
 	ctx := &doctorContext{}
-	wd, _ := os.Getwd()
 	checks := []doctorCheck{
 		{Name: "git installed", Fn: requireOnPath("git")},
-		{Name: "gh installed", Fn: requireOnPath("gh")},
-		{Name: "gh authenticated", Fn: checkGhAuth},
+		{Name: "gh installed", Fn: unlessGhDisabled(requireOnPath("gh"))},
+		{Name: "gh authenticated", Fn: unlessGhDisabled(checkGhAuth)},
+		{Name: "config valid", Fn: checkConfig(cmd.OutOrStdout(), fix)},
 		{Name: "project layout", Fn: func(c *doctorContext) error {
-			proj, err := project.Discover(wd)
+			proj, err := loadProjectFromWD()
 			if err != nil {
 				return err
 			}
 			c.Project = proj
 			return nil
 		}},
-		{Name: "default branch matches GitHub", Fn: checkDefaultBranch},
+		{Name: "default worktree matches default_branch", Fn: checkDefaultWorktreeName},
+		{Name: "default branch matches GitHub", Fn: unlessGhDisabled(checkDefaultBranch)},
 		{Name: "shell wrapper active", Fn: func(*doctorContext) error {
 			if !shellbridge.Active() {
 				return errors.New("shell wrapper inactive; add `eval \"$(wt activate)\"` to your shell")
@@ -59,12 +91,25 @@ func runDoctor(cmd *cobra.Command, verbose bool) error {
 			return nil
 		}},
 		{Name: "process detection available", Fn: checkProcessDetection},
-		{Name: "github actions reachable", Fn: checkGitHubActions},
+		{Name: "disk space", Fn: checkDiskSpace},
+		{Name: "github actions reachable", Fn: unlessGhDisabled(checkGitHubActions)},
 	}
 
 	var failures []string
 	for _, check := range checks {
 		err := check.Fn(ctx)
+		var skipped doctorSkipped
+		if errors.As(err, &skipped) {
+			if verbose {
+				fmt.Fprintf(cmd.OutOrStdout(), "- %s: skipped, %s\n", check.Name, skipped)
+			}
+			continue
+		}
+		var warning doctorWarning
+		if errors.As(err, &warning) {
+			fmt.Fprintf(cmd.ErrOrStderr(), "! %s: %s\n", check.Name, warning)
+			continue
+		}
 		if err != nil {
 			failures = append(failures, fmt.Sprintf("✗ %s: %v", check.Name, err))
 			continue
@@ -95,10 +140,53 @@ func requireOnPath(binary string) func(*doctorContext) error {
 }
 
 func checkGhAuth(*doctorContext) error {
-	cmd := exec.Command("gh", "auth", "status", "--exit-status")
-	cmd.Stdout = io.Discard
-	cmd.Stderr = io.Discard
-	return cmd.Run()
+	return ghAuthStatus(context.Background())
+}
+
+// checkConfig validates .wt/config.toml on its own so every bad setting is
+// named, instead of project discovery failing on the first one. With fix, the
+// invalid settings are reset to their defaults and the file is rewritten.
+func checkConfig(out io.Writer, fix bool) func(*doctorContext) error {
+	return func(*doctorContext) error {
+		root, path, err := locateConfigFromWD()
+		if err != nil {
+			return err
+		}
+		cfg, err := config.Read(path)
+		if err != nil {
+			return err
+		}
+		problems := cfg.Problems()
+		if len(problems) == 0 {
+			return nil
+		}
+		if fix {
+			defaultName, _ := project.DefaultWorktreeName(root)
+			changes := cfg.Repair(defaultName)
+			if err := config.Save(path, cfg); err != nil {
+				return fmt.Errorf("rewrite %s: %w", path, err)
+			}
+			for _, change := range changes {
+				fmt.Fprintf(out, "fixed config: %s\n", change)
+			}
+			return nil
+		}
+		msgs := make([]string, 0, len(problems))
+		for _, problem := range problems {
+			msgs = append(msgs, problem.Error())
+		}
+		return fmt.Errorf("%s (run `wt doctor --fix` to reset invalid settings)", strings.Join(msgs, "; "))
+	}
+}
+
+func checkDefaultWorktreeName(ctx *doctorContext) error {
+	if ctx.Project == nil {
+		return nil
+	}
+	if ctx.Project.DefaultWorktree != ctx.Project.Config.DefaultBranch {
+		return doctorWarning(fmt.Sprintf("default worktree is %s/ but default_branch = %q", ctx.Project.DefaultWorktree, ctx.Project.Config.DefaultBranch))
+	}
+	return nil
 }
 
 func checkDefaultBranch(ctx *doctorContext) error {
@@ -139,22 +227,84 @@ func checkProcessDetection(*doctorContext) error {
 	return errors.New("process scanner unavailable (could not observe wt)")
 }
 
+// Worktrees multiply checkouts and their dependency trees, so doctor warns
+// before the filesystem holding the project fills up.
+const (
+	doctorMinFreeBytes       = 5 << 30
+	doctorMinFreeInodesRatio = 0.05
+)
+
+var errDiskStatUnsupported = errors.New("disk usage unsupported on this platform")
+
+// diskUsage describes the filesystem holding a path, counting only the space
+// available to unprivileged users.
+type diskUsage struct {
+	FreeBytes   uint64
+	FreeInodes  uint64
+	TotalInodes uint64
+}
+
+// checkDiskSpace warns when the filesystem holding the project is low on
+// space or inodes.
+func checkDiskSpace(ctx *doctorContext) error {
+	usage, ok, err := projectDiskUsage(ctx)
+	if !ok || err != nil {
+		return err
+	}
+	if warnings := diskWarnings(usage); len(warnings) > 0 {
+		return doctorWarning(fmt.Sprintf("%s on %s; run `wt tidy` to remove finished worktrees", strings.Join(warnings, " and "), ctx.Project.Root))
+	}
+	return nil
+}
+
+// diskWarnings describes each threshold usage falls below.
+func diskWarnings(usage diskUsage) []string {
+	var warnings []string
+	if usage.FreeBytes < doctorMinFreeBytes {
+		warnings = append(warnings, fmt.Sprintf("only %.1f GiB free", float64(usage.FreeBytes)/(1<<30)))
+	}
+	// Some filesystems (btrfs, for one) allocate inodes dynamically and
+	// report no fixed total.
+	if usage.TotalInodes > 0 {
+		ratio := float64(usage.FreeInodes) / float64(usage.TotalInodes)
+		if ratio < doctorMinFreeInodesRatio {
+			warnings = append(warnings, fmt.Sprintf("only %.1f%% of inodes free (node_modules and similar trees use many)", ratio*100))
+		}
+	}
+	return warnings
+}
+
+// projectDiskUsage stats the filesystem holding the project root. ok is false
+// when there is nothing to check: no project, or an unsupported platform.
+func projectDiskUsage(ctx *doctorContext) (diskUsage, bool, error) {
+	if ctx.Project == nil {
+		return diskUsage{}, false, nil
+	}
+	usage, err := statDisk(ctx.Project.Root)
+	if errors.Is(err, errDiskStatUnsupported) {
+		return diskUsage{}, false, nil
+	}
+	if err != nil {
+		return diskUsage{}, false, fmt.Errorf("statfs %s: %w", ctx.Project.Root, err)
+	}
+	return usage, true, nil
+}
+
 func checkGitHubActions(ctx *doctorContext) error {
 	if ctx.Project == nil {
-		wd, err := os.Getwd()
-		if err != nil {
-			return err
-		}
-		proj, err := project.Discover(wd)
+		proj, err := loadProjectFromWD()
 		if err != nil {
 			return err
 		}
 		ctx.Project = proj
 	}
-	repo, err := resolveGitHubRepo(ctx.Project)
+	repo, err := resolveForgeRepo(ctx.Project)
 	if err != nil {
 		return err
 	}
+	if !repo.usesGh() {
+		return fmt.Errorf("remote host %s is not github.com", repo.Forge.Repo().Host)
+	}
 	path := fmt.Sprintf("repos/%s/actions/runs?per_page=1", repo.slug())
 	cmd := exec.Command("gh", "api", path)
 	cmd.Dir = ctx.Project.DefaultWorktreePath
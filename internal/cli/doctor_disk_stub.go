@@ -0,0 +1,7 @@
+//go:build !linux && !darwin
+
+package cli
+
+func statDisk(string) (diskUsage, error) {
+	return diskUsage{}, errDiskStatUnsupported
+}
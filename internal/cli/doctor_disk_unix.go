@@ -0,0 +1,17 @@
+//go:build linux || darwin
+
+package cli
+
+import "syscall"
+
+func statDisk(path string) (diskUsage, error) {
+	var st syscall.Statfs_t
+	if err := syscall.Statfs(path, &st); err != nil {
+		return diskUsage{}, err
+	}
+	return diskUsage{
+		FreeBytes:   uint64(st.Bavail) * uint64(st.Bsize),
+		FreeInodes:  uint64(st.Ffree),
+		TotalInodes: uint64(st.Files),
+	}, nil
+}
@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiskWarnings(t *testing.T) {
+	cases := []struct {
+		name  string
+		usage diskUsage
+		want  []string
+	}{
+		{
+			name:  "healthy",
+			usage: diskUsage{FreeBytes: 50 << 30, FreeInodes: 900, TotalInodes: 1000},
+		},
+		{
+			name:  "low space",
+			usage: diskUsage{FreeBytes: 1 << 29, FreeInodes: 900, TotalInodes: 1000},
+			want:  []string{"only 0.5 GiB free"},
+		},
+		{
+			name:  "low inodes",
+			usage: diskUsage{FreeBytes: 50 << 30, FreeInodes: 20, TotalInodes: 1000},
+			want:  []string{"only 2.0% of inodes free (node_modules and similar trees use many)"},
+		},
+		{
+			name:  "both low",
+			usage: diskUsage{FreeBytes: 1 << 30, FreeInodes: 0, TotalInodes: 1000},
+			want:  []string{"only 1.0 GiB free", "only 0.0% of inodes free (node_modules and similar trees use many)"},
+		},
+		{
+			name:  "no inode total",
+			usage: diskUsage{FreeBytes: 50 << 30},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := diskWarnings(tc.usage); !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("diskWarnings(%+v) = %q, want %q", tc.usage, got, tc.want)
+			}
+		})
+	}
+}
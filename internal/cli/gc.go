@@ -0,0 +1,118 @@
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/brandonbloom/wt/internal/gitutil"
+	"github.com/brandonbloom/wt/internal/project"
+	"github.com/spf13/cobra"
+)
+
+type gcOptions struct {
+	dryRun bool
+}
+
+func newGCCommand() *cobra.Command {
+	opts := &gcOptions{}
+	cmd := &cobra.Command{
+		Use:   "gc",
+		Short: "Delete merged local branches that have no worktree and no remote",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runGC(cmd, opts)
+		},
+	}
+	cmd.Flags().BoolVarP(&opts.dryRun, "dry-run", "n", false, "list orphaned branches without deleting them")
+	return cmd
+}
+
+func runGC(cmd *cobra.Command, opts *gcOptions) error {
+	proj, err := loadProjectFromWD()
+	if err != nil {
+		return err
+	}
+	compareCtx := defaultBranchComparisonContext(proj)
+	worktrees, err := project.ListWorktrees(proj.Root)
+	if err != nil {
+		return err
+	}
+	orphans, err := orphanedBranches(proj, compareCtx.CompareRef, worktrees)
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	if len(orphans) == 0 {
+		fmt.Fprintln(out, "No orphaned branches")
+		return nil
+	}
+	verb := "Found"
+	if opts.dryRun {
+		verb = "Would delete"
+	}
+	fmt.Fprintf(out, "%s %d orphaned %s merged into %s:\n", verb, len(orphans), pluralize(len(orphans), "branch", "branches"), compareCtx.CompareRef)
+	for _, branch := range orphans {
+		fmt.Fprintf(out, "  %s\n", branch)
+	}
+	if opts.dryRun {
+		return nil
+	}
+
+	fmt.Fprint(out, "Delete these branches? [y/N]: ")
+	resp, err := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	fmt.Fprintln(out)
+	resp = strings.TrimSpace(strings.ToLower(resp))
+	if resp != "y" && resp != "yes" {
+		fmt.Fprintln(out, "No branches deleted")
+		return nil
+	}
+	for _, branch := range orphans {
+		if err := gitDeleteLocalBranch(proj.DefaultWorktreePath, branch, out); err != nil {
+			return fmt.Errorf("delete branch %s: %w", branch, err)
+		}
+	}
+	return nil
+}
+
+// orphanedBranches lists local branches other than the protected default and
+// integration branches that no worktree has checked out, that have no
+// counterpart on origin, and whose commits are all reachable from compareRef.
+func orphanedBranches(proj *project.Project, compareRef string, worktrees []project.Worktree) ([]string, error) {
+	repoDir := proj.DefaultWorktreePath
+	branches, err := gitutil.LocalBranches(repoDir)
+	if err != nil {
+		return nil, err
+	}
+	checkedOut := make(map[string]bool, len(worktrees))
+	for _, wt := range worktrees {
+		if wt.Branch != "" {
+			checkedOut[wt.Branch] = true
+		}
+	}
+	var orphans []string
+	for _, branch := range branches {
+		if protectedBranchKind(proj, branch) != "" || checkedOut[branch] {
+			continue
+		}
+		if _, exists, err := gitutil.RemoteBranchHead(repoDir, "origin", branch); err != nil {
+			return nil, err
+		} else if exists {
+			continue
+		}
+		merged, err := gitutil.RevMergedInto(repoDir, "refs/heads/"+branch, compareRef)
+		if err != nil {
+			return nil, err
+		}
+		if merged {
+			orphans = append(orphans, branch)
+		}
+	}
+	return orphans, nil
+}
@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/brandonbloom/wt/internal/project"
+)
+
+func TestOrphanedBranches(t *testing.T) {
+	proj := initTempProject(t, "default_branch = \"main\"\nintegration_branch = \"develop\"\n")
+	root, repo := proj.Root, proj.DefaultWorktreePath
+	origin := filepath.Join(root, "origin.git")
+	gitCmd(t, root, "init", "--bare", "-b", "main", origin)
+	gitCmd(t, repo, "remote", "add", "origin", origin)
+	gitCmd(t, repo, "push", "origin", "main")
+
+	// merged and its siblings all point at main; only unmerged adds a commit.
+	for _, branch := range []string{"merged", "pushed", "checked-out", "develop", "unmerged"} {
+		gitCmd(t, repo, "branch", branch)
+	}
+	gitCmd(t, repo, "push", "origin", "pushed")
+	checkedOutPath := filepath.Join(root, "checked-out")
+	gitCmd(t, repo, "worktree", "add", checkedOutPath, "checked-out")
+
+	gitCmd(t, repo, "checkout", "-q", "unmerged")
+	writeFile(t, filepath.Join(repo, "feature.txt"), "feature\n")
+	gitCmd(t, repo, "add", "feature.txt")
+	gitCmd(t, repo, "commit", "-m", "feature")
+	gitCmd(t, repo, "checkout", "-q", "main")
+
+	worktrees := []project.Worktree{
+		{Name: "main", Path: repo, Branch: "main"},
+		{Name: "checked-out", Path: checkedOutPath, Branch: "checked-out"},
+	}
+	got, err := orphanedBranches(proj, "main", worktrees)
+	if err != nil {
+		t.Fatalf("orphanedBranches: %v", err)
+	}
+	if want := []string{"merged"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("orphanedBranches = %v, want %v", got, want)
+	}
+}
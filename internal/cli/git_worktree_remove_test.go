@@ -7,6 +7,8 @@ import (
 	"os/exec"
 	"path/filepath"
 	"testing"
+
+	"github.com/brandonbloom/wt/internal/project"
 )
 
 func TestGitWorktreeRemoveHandlesReadOnlyModuleCache(t *testing.T) {
@@ -26,6 +28,22 @@ func TestGitWorktreeRemoveHandlesReadOnlyModuleCache(t *testing.T) {
 	}
 }
 
+func TestSweepWorktreeDirRemovesReadOnlyLeftovers(t *testing.T) {
+	worktreePath := filepath.Join(t.TempDir(), "feature")
+	createReadOnlyModuleCache(t, worktreePath)
+	makeReadOnly(t, worktreePath)
+
+	if err := sweepWorktreeDir(worktreePath); err != nil {
+		t.Fatalf("sweepWorktreeDir: %v", err)
+	}
+	if _, err := os.Stat(worktreePath); !os.IsNotExist(err) {
+		t.Fatalf("expected leftover directory to be removed, got err=%v", err)
+	}
+	if err := sweepWorktreeDir(worktreePath); err != nil {
+		t.Fatalf("sweepWorktreeDir on missing path: %v", err)
+	}
+}
+
 func initTempRepo(t *testing.T) string {
 	t.Helper()
 
@@ -43,6 +61,31 @@ func initTempRepo(t *testing.T) string {
 	return dir
 }
 
+// initTempProject lays out a wt project around initTempRepo: the repository
+// becomes the default worktree main/, on branch main, and cfg is written to
+// .wt/config.toml.
+func initTempProject(t *testing.T, cfg string) *project.Project {
+	t.Helper()
+
+	repo := initTempRepo(t)
+	gitCmd(t, repo, "branch", "-M", "main")
+	root := t.TempDir()
+	if err := os.Rename(repo, filepath.Join(root, "main")); err != nil {
+		t.Fatalf("move repo: %v", err)
+	}
+	if err := os.Mkdir(filepath.Join(root, ".wt"), 0o755); err != nil {
+		t.Fatalf("mkdir .wt: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(root, ".wt", "config.toml"), []byte(cfg), 0o644); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	proj, err := project.Load(root)
+	if err != nil {
+		t.Fatalf("project.Load: %v", err)
+	}
+	return proj
+}
+
 func createReadOnlyModuleCache(t *testing.T, worktree string) {
 	t.Helper()
 
@@ -1,43 +1,154 @@
 package cli
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"os"
+	"os/exec"
 	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
 
+	"github.com/brandonbloom/wt/internal/forge"
 	"github.com/brandonbloom/wt/internal/gitutil"
 	"github.com/brandonbloom/wt/internal/project"
 )
 
-type githubRepo struct {
+// forgeRepo is the repository behind a git remote, together with the forge
+// (see forge.Detect) that serves its pull requests and CI.
+type forgeRepo struct {
 	Owner  string
 	Name   string
 	Remote string
+	Forge  forge.Forge
 }
 
-func (r githubRepo) slug() string {
+func projectGitDir(proj *project.Project) string {
+	if proj.DefaultWorktreePath != "" {
+		return proj.DefaultWorktreePath
+	}
+	return filepath.Join(proj.Root, proj.DefaultWorktree)
+}
+
+func (r forgeRepo) slug() string {
 	return fmt.Sprintf("%s/%s", r.Owner, r.Name)
 }
 
-func resolveGitHubRepo(proj *project.Project) (*githubRepo, error) {
+// usesGh reports whether lookups for r go through gh. An unresolved repo
+// counts as GitHub, so gh problems are still reported when the remote is
+// missing.
+func (r *forgeRepo) usesGh() bool {
+	return r == nil || r.Forge == nil || r.Forge.Repo().Kind == forge.GitHub
+}
+
+// resolveForgeRepo identifies the repository behind the configured CI remote
+// ([ci].remote, default origin) and the forge hosting it. PR and CI lookups
+// both query it.
+func resolveForgeRepo(proj *project.Project) (*forgeRepo, error) {
 	if proj == nil {
 		return nil, fmt.Errorf("project not loaded")
 	}
-	remote := proj.Config.CIRemote()
-	workdir := proj.DefaultWorktreePath
-	if workdir == "" {
-		workdir = filepath.Join(proj.Root, proj.DefaultWorktree)
+	return resolveForgeRepoFromRemote(proj, proj.Config.CIRemote())
+}
+
+func resolveForgeRepoFromRemote(proj *project.Project, remote string) (*forgeRepo, error) {
+	if proj == nil {
+		return nil, fmt.Errorf("project not loaded")
 	}
-	url, err := gitutil.RemoteURL(workdir, remote)
+	url, err := gitutil.RemoteURL(projectGitDir(proj), remote)
 	if err != nil {
 		return nil, fmt.Errorf("git remote %s: %w", remote, err)
 	}
-	owner, name, err := gitutil.ParseGitHubRemote(url)
+	f, err := forge.Detect(url)
 	if err != nil {
 		return nil, err
 	}
-	return &githubRepo{
-		Owner:  owner,
-		Name:   name,
+	repo := f.Repo()
+	return &forgeRepo{
+		Owner:  repo.Owner,
+		Name:   repo.Name,
 		Remote: remote,
+		Forge:  f,
 	}, nil
 }
+
+var (
+	errGhNotFound         = errors.New("gh not found on PATH")
+	errGhNotAuthenticated = errors.New("gh not authenticated; run `gh auth login`")
+	// errGhDisabled takes the place of a gh failure when GitHub access is
+	// switched off, so callers reuse their skip paths without warning.
+	errGhDisabled = errors.New("GitHub access disabled (WT_NO_GH or [gh].enabled = false)")
+)
+
+// ghDisabled reports whether every gh invocation is switched off, leaving
+// commands to work from local git data. WT_NO_GH wins over [gh].enabled in
+// either direction.
+func ghDisabled(proj *project.Project) bool {
+	if value := strings.TrimSpace(os.Getenv("WT_NO_GH")); value != "" {
+		if off, err := strconv.ParseBool(value); err == nil {
+			return off
+		}
+		return true
+	}
+	return proj != nil && !proj.Config.GH.IsEnabled()
+}
+
+// requireGh fails fast for commands that cannot do their job without gh.
+func requireGh(proj *project.Project) error {
+	if ghDisabled(proj) {
+		return errGhDisabled
+	}
+	if _, err := exec.LookPath("gh"); err != nil {
+		return fmt.Errorf("gh CLI required: %w", err)
+	}
+	return nil
+}
+
+// ghAuthStatus asks gh whether it has working credentials, so callers can
+// report one clear problem instead of a cryptic error per worktree.
+func ghAuthStatus(ctx context.Context) error {
+	if _, err := exec.LookPath("gh"); err != nil {
+		return errGhNotFound
+	}
+	cmd := exec.CommandContext(ctx, "gh", "auth", "status", "--exit-status")
+	cmd.Stdout = io.Discard
+	cmd.Stderr = io.Discard
+	if err := cmd.Run(); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		return errGhNotAuthenticated
+	}
+	return nil
+}
+
+// startGhAuthCheck runs ghAuthStatus in the background so the round trip
+// overlaps with local git work. The returned function waits for the result and
+// reports only errGhNotAuthenticated: without gh at all, lookups fail per row
+// as before. With GitHub access disabled gh never runs and the result is
+// errGhDisabled; for repos on other forges gh is not consulted at all.
+func startGhAuthCheck(ctx context.Context, proj *project.Project, repo *forgeRepo) func() error {
+	if ghDisabled(proj) {
+		return func() error { return errGhDisabled }
+	}
+	if !repo.usesGh() {
+		return func() error { return nil }
+	}
+	done := make(chan error, 1)
+	go func() {
+		done <- ghAuthStatus(ctx)
+	}()
+	var once sync.Once
+	var err error
+	return func() error {
+		once.Do(func() {
+			if result := <-done; errors.Is(result, errGhNotAuthenticated) {
+				err = result
+			}
+		})
+		return err
+	}
+}
@@ -0,0 +1,79 @@
+package cli
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brandonbloom/wt/internal/forge"
+	"github.com/brandonbloom/wt/internal/project"
+)
+
+func TestResolveForgeRepoDetectsHost(t *testing.T) {
+	dir := initTempRepo(t)
+	gitCmd(t, dir, "remote", "add", "origin", "git@bitbucket.org:acme/widgets.git")
+	gitCmd(t, dir, "remote", "add", "hub", "https://github.com/acme/widgets")
+	proj := &project.Project{DefaultWorktreePath: dir}
+
+	repo, err := resolveForgeRepoFromRemote(proj, "origin")
+	if err != nil {
+		t.Fatalf("resolveForgeRepoFromRemote(origin): %v", err)
+	}
+	if kind := repo.Forge.Repo().Kind; kind != forge.Bitbucket || repo.usesGh() {
+		t.Fatalf("origin forge = %s (usesGh %v), want bitbucket without gh", kind, repo.usesGh())
+	}
+	if repo.slug() != "acme/widgets" {
+		t.Fatalf("slug = %q, want acme/widgets", repo.slug())
+	}
+
+	repo, err = resolveForgeRepoFromRemote(proj, "hub")
+	if err != nil {
+		t.Fatalf("resolveForgeRepoFromRemote(hub): %v", err)
+	}
+	if !repo.usesGh() {
+		t.Fatalf("hub forge = %s, want gh", repo.Forge.Repo().Kind)
+	}
+}
+
+type fakeForge struct {
+	prs  []forge.PullRequest
+	runs []forge.CIRun
+	sha  string
+}
+
+func (f *fakeForge) Repo() forge.Repo { return forge.Repo{Kind: forge.AzureDevOps} }
+
+func (f *fakeForge) ListPullRequests(ctx context.Context, dir, branch string, limit int) ([]forge.PullRequest, error) {
+	return f.prs, nil
+}
+
+func (f *fakeForge) ListCIRuns(ctx context.Context, dir, sha string) ([]forge.CIRun, error) {
+	f.sha = sha
+	return f.runs, nil
+}
+
+func TestLookupsGoThroughTheDetectedForge(t *testing.T) {
+	fake := &fakeForge{
+		prs:  []forge.PullRequest{{Number: 7, State: "OPEN"}},
+		runs: []forge.CIRun{{Name: "build", Status: "completed", Conclusion: "failure", URL: "https://example.com/run/1"}},
+	}
+	repo := &forgeRepo{Owner: "contoso", Name: "web", Forge: fake}
+
+	prs, err := queryPullRequests(context.Background(), repo, t.TempDir(), "feature", 5)
+	if err != nil {
+		t.Fatalf("queryPullRequests: %v", err)
+	}
+	if len(prs) != 1 || prs[0].Number != 7 {
+		t.Fatalf("prs = %+v, want #7 from the forge", prs)
+	}
+
+	res, err := fetchCITarget(context.Background(), ciFetchOptions{Repo: repo}, ciTarget{Ref: "refs/pull/7/merge", Branch: "feature", Head: "abc123", MergeRef: true})
+	if err != nil {
+		t.Fatalf("fetchCITarget: %v", err)
+	}
+	if fake.sha != "abc123" {
+		t.Fatalf("ListCIRuns sha = %q, want the head commit", fake.sha)
+	}
+	if res.State != ciStateFailure || res.Failure == nil || res.Failure.Name != "build" || res.MergeRef {
+		t.Fatalf("result = %+v, want the build failure on the head commit", res)
+	}
+}
@@ -1,8 +1,10 @@
 package cli
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -15,6 +17,14 @@ func isWithin(child, parent string) bool {
 	return rel == "." || !strings.HasPrefix(rel, "..")
 }
 
+// pluralize returns singular when n is 1 and plural otherwise.
+func pluralize(n int, singular, plural string) string {
+	if n == 1 {
+		return singular
+	}
+	return plural
+}
+
 func currentTimeOverride() time.Time {
 	if override := os.Getenv("WT_NOW"); override != "" {
 		if t, err := time.Parse(time.RFC3339, override); err == nil {
@@ -23,3 +33,32 @@ func currentTimeOverride() time.Time {
 	}
 	return time.Now()
 }
+
+// parseDurationWithDays extends time.ParseDuration with a leading day
+// component, so "7d", "1d12h", and "48h" are all accepted.
+func parseDurationWithDays(spec string) (time.Duration, error) {
+	spec = strings.TrimSpace(spec)
+	if spec == "" {
+		return 0, fmt.Errorf("empty duration")
+	}
+	var total time.Duration
+	if idx := strings.Index(spec, "d"); idx > 0 {
+		days, err := strconv.Atoi(spec[:idx])
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", spec)
+		}
+		total = time.Duration(days) * 24 * time.Hour
+		spec = spec[idx+1:]
+	}
+	if spec != "" {
+		d, err := time.ParseDuration(spec)
+		if err != nil {
+			return 0, err
+		}
+		total += d
+	}
+	if total <= 0 {
+		return 0, fmt.Errorf("duration must be positive")
+	}
+	return total, nil
+}
@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDurationWithDays(t *testing.T) {
+	cases := []struct {
+		spec    string
+		want    time.Duration
+		wantErr bool
+	}{
+		{spec: "7d", want: 7 * 24 * time.Hour},
+		{spec: "48h", want: 48 * time.Hour},
+		{spec: "1d12h", want: 36 * time.Hour},
+		{spec: " 30m ", want: 30 * time.Minute},
+		{spec: "", wantErr: true},
+		{spec: "xd", wantErr: true},
+		{spec: "0d", wantErr: true},
+		{spec: "soon", wantErr: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.spec, func(t *testing.T) {
+			got, err := parseDurationWithDays(tc.spec)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("parseDurationWithDays(%q) = %s, want error", tc.spec, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseDurationWithDays(%q): %v", tc.spec, err)
+			}
+			if got != tc.want {
+				t.Fatalf("parseDurationWithDays(%q) = %s, want %s", tc.spec, got, tc.want)
+			}
+		})
+	}
+}
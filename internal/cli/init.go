@@ -12,23 +12,78 @@ import (
 	"github.com/spf13/cobra"
 )
 
+type initOptions struct {
+	bare   bool
+	origin string
+}
+
 func newInitCommand() *cobra.Command {
+	opts := &initOptions{}
 	cmd := &cobra.Command{
-		Use:   "init",
+		Use:   "init [--bare <name>]",
 		Short: "Initialize the current repository for wt",
-		RunE:  runInit,
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runInit(cmd, opts, args)
+		},
 	}
+	cmd.Flags().BoolVar(&opts.bare, "bare", false, "create a new empty project in wt layout instead of converting the current repository")
+	cmd.Flags().StringVar(&opts.origin, "origin", "", "with --bare, add this URL as the origin remote")
 	return cmd
 }
 
-func runInit(cmd *cobra.Command, args []string) error {
+func runInit(cmd *cobra.Command, opts *initOptions, args []string) error {
 	wd, err := os.Getwd()
 	if err != nil {
 		return err
 	}
+	if opts.bare {
+		if len(args) != 1 {
+			return errors.New("wt init --bare requires a project name")
+		}
+		return initializeBareProject(cmd, filepath.Join(wd, args[0]), opts.origin)
+	}
+	if len(args) > 0 {
+		return fmt.Errorf("unexpected argument %q (did you mean wt init --bare %s?)", args[0], args[0])
+	}
+	if opts.origin != "" {
+		return errors.New("--origin requires --bare")
+	}
 	return initializeInDirectory(cmd, wd)
 }
 
+// initializeBareProject creates <root>/main from an empty `git init` and
+// writes the wt config, producing the same layout convertLegacyRepo would.
+func initializeBareProject(cmd *cobra.Command, root, origin string) error {
+	const branch = "main"
+	if exists(root) {
+		return fmt.Errorf("target path already exists: %s", root)
+	}
+	if err := os.Mkdir(root, 0o755); err != nil {
+		return err
+	}
+	branchPath := filepath.Join(root, branch)
+	if _, err := gitutil.Run(root, "init", "--quiet", "--initial-branch="+branch, branchPath); err != nil {
+		_ = os.RemoveAll(root)
+		return err
+	}
+	if origin != "" {
+		if _, err := gitutil.Run(branchPath, "remote", "add", "origin", origin); err != nil {
+			_ = os.RemoveAll(root)
+			return err
+		}
+	}
+	if _, err := project.EnsureConfig(root, branch); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Created wt project at %s\n", root)
+	if err := shellbridge.ChangeDirectory(branchPath); err != nil {
+		fmt.Fprintf(cmd.OutOrStdout(), "Please cd into %s\n", branchPath)
+	}
+	return nil
+}
+
 func initializeInDirectory(cmd *cobra.Command, dir string) error {
 	if handled, err := tryInitializeExistingLayout(cmd, dir); err != nil {
 		return err
@@ -3,7 +3,10 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"io"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/brandonbloom/wt/internal/processes"
 	"github.com/brandonbloom/wt/internal/project"
@@ -15,14 +18,17 @@ type killOptions struct {
 	signalFlag  string
 	timeoutFlag string
 	sig9        bool
+	all         bool
+	wait        bool
+	escalate    bool
+	commands    []string
 }
 
 func newKillCommand() *cobra.Command {
 	opts := &killOptions{}
 	cmd := &cobra.Command{
-		Use:   "kill <worktrees...>",
+		Use:   "kill [<worktrees...>]",
 		Short: "Terminate processes running inside worktrees",
-		Args:  cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runKill(cmd, opts, args)
 		},
@@ -32,10 +38,21 @@ func newKillCommand() *cobra.Command {
 	cmd.Flags().StringVar(&opts.timeoutFlag, "timeout", "", "time to wait for processes to exit (e.g. 3s)")
 	cmd.Flags().BoolVarP(&opts.sig9, "sigkill", "9", false, "shorthand for --signal=9")
 	_ = cmd.Flags().MarkHidden("sigkill")
+	cmd.Flags().BoolVarP(&opts.all, "all", "a", false, "target every worktree")
+	cmd.Flags().BoolVarP(&opts.wait, "wait", "w", false, "show progress while waiting for processes to exit")
+	cmd.Flags().BoolVar(&opts.escalate, "escalate", false, "re-signal survivors with process.escalate_signal (default KILL) after the timeout")
+	cmd.Flags().StringArrayVar(&opts.commands, "command", nil, "only kill processes whose command name matches (repeatable, case-insensitive)")
 	return cmd
 }
 
 func runKill(cmd *cobra.Command, opts *killOptions, args []string) error {
+	if opts.all && len(args) > 0 {
+		return errors.New("cannot combine --all with explicit worktrees")
+	}
+	if !opts.all && len(args) == 0 {
+		return errors.New("specify worktrees to kill processes in, or pass --all")
+	}
+
 	proj, err := loadProjectFromWD()
 	if err != nil {
 		return err
@@ -50,9 +67,12 @@ func runKill(cmd *cobra.Command, opts *killOptions, args []string) error {
 	if err != nil {
 		return err
 	}
-	targets, err := resolveWorktreeArgs(worktrees, args, wd)
-	if err != nil {
-		return err
+	targets := worktrees
+	if !opts.all {
+		targets, err = resolveWorktreeArgs(worktrees, args, targetBaseDir(proj, wd))
+		if err != nil {
+			return err
+		}
 	}
 
 	signalSpec := opts.signalFlag
@@ -63,6 +83,11 @@ func runKill(cmd *cobra.Command, opts *killOptions, args []string) error {
 	if err != nil {
 		return err
 	}
+	if opts.escalate {
+		if err := settings.enableEscalation(proj.Config.Process.EscalateSignal); err != nil {
+			return err
+		}
+	}
 
 	processMap, supported, err := detectWorktreeProcesses(targets)
 	if err != nil {
@@ -74,15 +99,22 @@ func runKill(cmd *cobra.Command, opts *killOptions, args []string) error {
 
 	terminator := newProcessTerminator()
 	out := cmd.OutOrStdout()
+	now := currentTimeOverride()
 	var combined error
 
 	for i, target := range targets {
 		key := canonicalizePath(target.Path)
 		procs := append([]processes.Process(nil), processMap[key]...)
+		found := len(procs)
+		procs = filterProcessesByCommand(procs, opts.commands)
 
 		fmt.Fprintf(out, "%s:\n", target.Name)
 		if len(procs) == 0 {
-			fmt.Fprintln(out, "  nothing to kill")
+			if found > 0 {
+				fmt.Fprintf(out, "  no processes matching --command %s\n", strings.Join(opts.commands, ", "))
+			} else {
+				fmt.Fprintln(out, "  nothing to kill")
+			}
 			if i < len(targets)-1 {
 				fmt.Fprintln(out)
 			}
@@ -90,15 +122,34 @@ func runKill(cmd *cobra.Command, opts *killOptions, args []string) error {
 		}
 
 		for _, proc := range procs {
-			fmt.Fprintf(out, "  - %s (%d)\n", processCommandLabel(proc.Command), proc.PID)
+			fmt.Fprintf(out, "  - %s\n", processListingLabel(proc, now))
 		}
-		action := fmt.Sprintf("%s to %d %s", settings.SignalLabel, len(procs), pluralizeProcess(len(procs)))
+		action := fmt.Sprintf("%s to %d %s", settings.SignalLabel, len(procs), pluralize(len(procs), "process", "processes"))
 		if opts.dryRun {
 			fmt.Fprintf(out, "  would send %s\n", action)
 		} else {
 			fmt.Fprintf(out, "  sending %s\n", action)
-			if err := terminateWorktreeProcesses(cmd.Context(), target, procs, settings, terminator); err != nil {
+			var progress *killProgress
+			if opts.wait {
+				progress = newKillProgress(out, writerIsTerminal(out))
+			}
+			hooks := killHooks{
+				Progress: progress.update,
+				Escalating: func(remaining []processes.Process) {
+					progress.finish()
+					fmt.Fprintf(out, "  escalating to %s for %d %s\n", settings.EscalateSignalLabel, len(remaining), pluralize(len(remaining), "process", "processes"))
+				},
+			}
+			err := terminateWorktreeProcesses(cmd.Context(), target, procs, settings, terminator, hooks)
+			progress.finish()
+			if err != nil {
 				fmt.Fprintf(out, "  error: %s\n", singleLineError(err))
+				var survivors *survivorsError
+				if errors.As(err, &survivors) {
+					for _, proc := range survivors.Survivors {
+						fmt.Fprintf(out, "    still running: %s\n", survivorLabel(proc, now))
+					}
+				}
 				combined = errors.Join(combined, fmt.Errorf("%s: %w", target.Name, err))
 			} else {
 				fmt.Fprintln(out, "  cleared")
@@ -113,9 +164,64 @@ func runKill(cmd *cobra.Command, opts *killOptions, args []string) error {
 	return combined
 }
 
-func pluralizeProcess(count int) string {
-	if count == 1 {
-		return "process"
+// filterProcessesByCommand keeps processes whose command label (the base name
+// of the executable) case-insensitively equals one of names. An empty names
+// list keeps everything.
+func filterProcessesByCommand(procs []processes.Process, names []string) []processes.Process {
+	if len(names) == 0 {
+		return procs
+	}
+	filtered := procs[:0]
+	for _, proc := range procs {
+		label := processCommandLabel(proc.Command)
+		for _, name := range names {
+			if strings.EqualFold(label, strings.TrimSpace(name)) {
+				filtered = append(filtered, proc)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+// killProgress renders "waiting for N processes..." while wt kill --wait
+// polls. On a terminal the line is rewritten in place; otherwise a new line is
+// printed only when the number of remaining processes changes.
+type killProgress struct {
+	out     io.Writer
+	inPlace bool
+	last    int
+	drawn   bool
+}
+
+func newKillProgress(out io.Writer, inPlace bool) *killProgress {
+	return &killProgress{out: out, inPlace: inPlace, last: -1}
+}
+
+// update is a waitProgressFunc; calling it on a nil receiver is a no-op, so
+// callers can pass progress.update whether or not --wait was given.
+func (p *killProgress) update(remaining []processes.Process, elapsed time.Duration) {
+	if p == nil {
+		return
+	}
+	count := len(remaining)
+	line := fmt.Sprintf("  waiting for %d %s... (%s)", count, pluralize(count, "process", "processes"), elapsed.Truncate(100*time.Millisecond))
+	if p.inPlace {
+		fmt.Fprintf(p.out, "\r\x1b[K%s", line)
+		p.drawn = true
+		return
+	}
+	if count != p.last {
+		fmt.Fprintf(p.out, "  waiting for %d %s...\n", count, pluralize(count, "process", "processes"))
+	}
+	p.last = count
+}
+
+// finish clears the in-place progress line, if one was drawn.
+func (p *killProgress) finish() {
+	if p == nil || !p.drawn {
+		return
 	}
-	return "processes"
+	fmt.Fprint(p.out, "\r\x1b[K")
+	p.drawn = false
 }
@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/brandonbloom/wt/internal/processes"
+	"github.com/brandonbloom/wt/internal/project"
+)
+
+func TestKillProgressPrintsCountChanges(t *testing.T) {
+	var buf bytes.Buffer
+	p := newKillProgress(&buf, false)
+	two := []processes.Process{{PID: 1}, {PID: 2}}
+	p.update(two, 100*time.Millisecond)
+	p.update(two, 200*time.Millisecond)
+	p.update(two[:1], 300*time.Millisecond)
+	p.finish()
+
+	want := "  waiting for 2 processes...\n  waiting for 1 process...\n"
+	if got := buf.String(); got != want {
+		t.Fatalf("progress output = %q, want %q", got, want)
+	}
+}
+
+func TestKillProgressRewritesLineInPlace(t *testing.T) {
+	var buf bytes.Buffer
+	p := newKillProgress(&buf, true)
+	p.update([]processes.Process{{PID: 1}}, 1500*time.Millisecond)
+	p.finish()
+
+	want := "\r\x1b[K  waiting for 1 process... (1.5s)\r\x1b[K"
+	if got := buf.String(); got != want {
+		t.Fatalf("progress output = %q, want %q", got, want)
+	}
+}
+
+func TestKillProgressNilIsNoop(t *testing.T) {
+	var p *killProgress
+	p.update([]processes.Process{{PID: 1}}, time.Second)
+	p.finish()
+}
+
+// stubbornTerminator ignores every signal except kill, which it forwards to
+// the test-data terminator so the process disappears from the listing.
+type stubbornTerminator struct {
+	inner *testProcessTerminator
+	kill  syscall.Signal
+	sent  []syscall.Signal
+}
+
+func (s *stubbornTerminator) Terminate(proc processes.Process, sig syscall.Signal) error {
+	s.sent = append(s.sent, sig)
+	if sig != s.kill {
+		return nil
+	}
+	return s.inner.Terminate(proc, sig)
+}
+
+func TestTerminateWorktreeProcessesEscalates(t *testing.T) {
+	dir := t.TempDir()
+	wt := project.Worktree{Name: "busy", Path: dir}
+	proc := processes.Process{PID: 4242, Command: "server", CWD: dir, State: "stopped"}
+	data, err := json.Marshal([]processes.Process{proc})
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "processes.json")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("WT_PROCESS_TEST_DATA_FILE", path)
+
+	settings := killSettings{Signal: syscall.SIGTERM, Timeout: 50 * time.Millisecond}
+	term := &stubbornTerminator{inner: &testProcessTerminator{path: path}, kill: syscall.SIGKILL}
+	err = terminateWorktreeProcesses(context.Background(), wt, []processes.Process{proc}, settings, term, killHooks{})
+	var survivors *survivorsError
+	if !errors.As(err, &survivors) {
+		t.Fatalf("expected survivors without --escalate, got %v", err)
+	}
+	if len(survivors.Survivors) != 1 {
+		t.Fatalf("survivors = %v, want 1", survivors.Survivors)
+	}
+	if got, want := survivorLabel(survivors.Survivors[0], time.Now()), "server (4242): stopped"; got != want {
+		t.Fatalf("survivor label = %q, want %q", got, want)
+	}
+
+	if err := settings.enableEscalation(""); err != nil {
+		t.Fatal(err)
+	}
+	var escalated int
+	hooks := killHooks{Escalating: func(remaining []processes.Process) { escalated = len(remaining) }}
+	term.sent = nil
+	if err := terminateWorktreeProcesses(context.Background(), wt, []processes.Process{proc}, settings, term, hooks); err != nil {
+		t.Fatalf("terminate with escalation: %v", err)
+	}
+	if escalated != 1 {
+		t.Fatalf("Escalating saw %d processes, want 1", escalated)
+	}
+	if len(term.sent) != 2 || term.sent[0] != syscall.SIGTERM || term.sent[1] != syscall.SIGKILL {
+		t.Fatalf("signals sent = %v, want [TERM KILL]", term.sent)
+	}
+}
@@ -0,0 +1,90 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/brandonbloom/wt/internal/gitutil"
+	"github.com/brandonbloom/wt/internal/project"
+	"github.com/spf13/cobra"
+)
+
+type lockOptions struct {
+	reason string
+}
+
+func newLockCommand() *cobra.Command {
+	opts := &lockOptions{}
+	cmd := &cobra.Command{
+		Use:   "lock <name>",
+		Short: "Lock a worktree with git worktree lock so tidy leaves it alone",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runLock(cmd, opts, args)
+		},
+	}
+	cmd.Flags().StringVar(&opts.reason, "reason", "", "explanation recorded with the lock")
+	return cmd
+}
+
+func newUnlockCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unlock <name>",
+		Short: "Unlock a worktree locked with git worktree lock",
+		Args:  cobra.ExactArgs(1),
+		RunE:  runUnlock,
+	}
+	return cmd
+}
+
+func runLock(cmd *cobra.Command, opts *lockOptions, args []string) error {
+	proj, wt, err := lookupNamedWorktree(args[0])
+	if err != nil {
+		return err
+	}
+	if wt.Locked {
+		fmt.Fprintf(cmd.OutOrStdout(), "%s is already locked\n", wt.Name)
+		return nil
+	}
+	gitArgs := []string{"worktree", "lock"}
+	if opts.reason != "" {
+		gitArgs = append(gitArgs, "--reason", opts.reason)
+	}
+	gitArgs = append(gitArgs, wt.Path)
+	if _, err := gitutil.Run(proj.DefaultWorktreePath, gitArgs...); err != nil {
+		return fmt.Errorf("git worktree lock failed: %w", err)
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Locked %s\n", wt.Name)
+	return nil
+}
+
+func runUnlock(cmd *cobra.Command, args []string) error {
+	proj, wt, err := lookupNamedWorktree(args[0])
+	if err != nil {
+		return err
+	}
+	if !wt.Locked {
+		fmt.Fprintf(cmd.OutOrStdout(), "%s is not locked\n", wt.Name)
+		return nil
+	}
+	if _, err := gitutil.Run(proj.DefaultWorktreePath, "worktree", "unlock", wt.Path); err != nil {
+		return fmt.Errorf("git worktree unlock failed: %w", err)
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Unlocked %s\n", wt.Name)
+	return nil
+}
+
+func lookupNamedWorktree(name string) (*project.Project, *project.Worktree, error) {
+	proj, err := loadProjectFromWD()
+	if err != nil {
+		return nil, nil, err
+	}
+	worktrees, err := project.ListWorktrees(proj.Root)
+	if err != nil {
+		return nil, nil, err
+	}
+	wt := findWorktreeByName(worktrees, name)
+	if wt == nil {
+		return nil, nil, fmt.Errorf("no worktree named %s", name)
+	}
+	return proj, wt, nil
+}
@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/brandonbloom/wt/internal/gitutil"
+	"github.com/brandonbloom/wt/internal/shellbridge"
+	"github.com/spf13/cobra"
+)
+
+func newMoveCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "move <name> <path>",
+		Short: "Relocate a worktree with git worktree move",
+		Args:  cobra.ExactArgs(2),
+		RunE:  runMove,
+	}
+	return cmd
+}
+
+func runMove(cmd *cobra.Command, args []string) error {
+	proj, wt, err := lookupNamedWorktree(args[0])
+	if err != nil {
+		return err
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	if wt.Name == proj.DefaultWorktree {
+		return fmt.Errorf("refusing to move the default worktree %s", wt.Name)
+	}
+	if wt.Main {
+		return fmt.Errorf("refusing to move %s: it is the repository's main working tree", wt.Name)
+	}
+
+	target := args[1]
+	if !filepath.IsAbs(target) {
+		target = filepath.Join(targetBaseDir(proj, wd), target)
+	}
+	target = filepath.Clean(target)
+
+	if _, err := gitutil.Run(proj.DefaultWorktreePath, "worktree", "move", wt.Path, target); err != nil {
+		return fmt.Errorf("git worktree move failed: %w", err)
+	}
+
+	if isWithin(wd, wt.Path) {
+		if err := shellbridge.ChangeDirectory(target); err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Moved %s to %s (run `cd %s`)\n", wt.Name, target, target)
+			return nil
+		}
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Moved %s to %s\n", wt.Name, target)
+	return nil
+}
@@ -1,13 +1,18 @@
 package cli
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"io"
+	"io/fs"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/brandonbloom/wt/internal/gitutil"
 	"github.com/brandonbloom/wt/internal/naming"
@@ -29,33 +34,87 @@ func newNewCommand() *cobra.Command {
 		},
 	}
 	cmd.Flags().StringVar(&opts.base, "base", "", "base branch for new worktree")
+	cmd.Flags().StringVar(&opts.worktreeFrom, "worktree-from", "", "start at another worktree's current HEAD commit, including unpushed commits")
+	cmd.Flags().BoolVarP(&opts.quiet, "quiet", "q", false, "print only the new worktree path to stdout")
+	cmd.Flags().BoolVar(&opts.copyConfig, "copy-config", false, "copy [new].copy_files from the current (or default) worktree")
+	cmd.Flags().StringVar(&opts.nameFromBranch, "name-from-branch", "", "create this branch, naming the worktree directory after a slug of it")
+	cmd.Flags().StringVar(&opts.suffix, "suffix", "", "branch off the current branch as <current>-<suffix>")
+	cmd.Flags().BoolVar(&opts.fetchBase, "fetch-base", false, "fetch origin/<default> and branch off it instead of the local base")
+	cmd.Flags().BoolVar(&opts.push, "push", false, "push the new branch to origin and set its upstream after bootstrap")
 	return cmd
 }
 
 type newOptions struct {
-	base string
+	base           string
+	quiet          bool
+	copyConfig     bool
+	nameFromBranch string
+	worktreeFrom   string
+	suffix         string
+	fetchBase      bool
+	push           bool
 }
 
 func runNew(cmd *cobra.Command, opts *newOptions, args []string) error {
+	if opts.worktreeFrom != "" && opts.base != "" {
+		return errors.New("cannot combine --base and --worktree-from")
+	}
+	if opts.fetchBase && (opts.base != "" || opts.worktreeFrom != "") {
+		return errors.New("cannot combine --fetch-base with --base or --worktree-from")
+	}
 	proj, err := loadProjectFromWD()
 	if err != nil {
 		return err
 	}
 
 	name := ""
-	if len(args) == 1 {
+	branch := ""
+	base := opts.base
+	switch {
+	case opts.suffix != "":
+		if len(args) == 1 || opts.nameFromBranch != "" {
+			return errors.New("cannot combine --suffix with a worktree name or --name-from-branch")
+		}
+		current, err := suffixSourceBranch()
+		if err != nil {
+			return err
+		}
+		branch = current + "-" + strings.TrimSpace(opts.suffix)
+		if base == "" && opts.worktreeFrom == "" {
+			base = current
+		}
+	case opts.nameFromBranch != "":
+		if len(args) == 1 {
+			return errors.New("cannot combine a worktree name with --name-from-branch")
+		}
+		branch = strings.TrimSpace(opts.nameFromBranch)
+	case len(args) == 1 && strings.Contains(args[0], "/"):
+		// feature/foo style names become the branch; the directory gets a slug.
+		branch = args[0]
+	case len(args) == 1:
 		name = args[0]
-	} else {
+	default:
 		name, err = naming.Generate()
 		if err != nil {
 			return fmt.Errorf("generate worktree name: %w", err)
 		}
-		fmt.Fprintf(cmd.OutOrStdout(), "Selected worktree name %s\n", name)
+		if !opts.quiet {
+			fmt.Fprintf(cmd.OutOrStdout(), "Selected worktree name %s\n", name)
+		}
 	}
 
+	if branch != "" {
+		if _, err := gitutil.Run(proj.DefaultWorktreePath, "check-ref-format", "--branch", branch); err != nil {
+			return fmt.Errorf("invalid branch name %q", branch)
+		}
+		name = worktreeNameFromBranch(branch)
+	}
 	if err := validateWorktreeName(name); err != nil {
 		return err
 	}
+	if branch == "" {
+		branch = name
+	}
 
 	targetPath := filepath.Join(proj.Root, name)
 	if _, err := os.Stat(targetPath); err == nil {
@@ -64,22 +123,53 @@ func runNew(cmd *cobra.Command, opts *newOptions, args []string) error {
 		return err
 	}
 
-	baseBranch, err := determineBaseBranch(opts.base, proj)
+	var baseBranch string
+	switch {
+	case opts.fetchBase:
+		baseBranch, err = fetchLatestBase(cmd, proj, opts.quiet)
+	case opts.worktreeFrom != "":
+		baseBranch, err = worktreeHeadCommit(proj, opts.worktreeFrom)
+	default:
+		baseBranch, err = determineBaseBranch(base, name, proj)
+	}
 	if err != nil {
 		return err
 	}
 
-	if err := addWorktree(cmd, proj, name, baseBranch, targetPath); err != nil {
+	if err := addWorktree(cmd, proj, branch, baseBranch, targetPath, opts.quiet, opts.fetchBase); err != nil {
 		return err
 	}
 
+	if opts.copyConfig {
+		if len(proj.Config.New.CopyFiles) == 0 {
+			fmt.Fprintln(cmd.ErrOrStderr(), "warning: --copy-config given but [new].copy_files is empty")
+		} else if err := copyWorktreeFiles(cmd.ErrOrStderr(), sourceWorktreePath(proj), targetPath, proj.Config.New.CopyFiles); err != nil {
+			return err
+		}
+	}
+
 	if err := runBootstrap(cmd, proj.Config.Bootstrap.Run, targetPath, bootstrapOptions{
-		strict: proj.Config.Bootstrap.StrictEnabled(),
+		strict:  proj.Config.Bootstrap.StrictEnabled(),
+		timeout: proj.Config.Bootstrap.TimeoutDuration(),
+		env:     proj.Config.Bootstrap.Env,
+		quiet:   opts.quiet,
 	}); err != nil {
 		return err
 	}
 
-	if err := shellbridge.ChangeDirectory(targetPath); err != nil {
+	if opts.push {
+		if err := pushNewBranch(cmd, targetPath, branch); err != nil {
+			return err
+		}
+	}
+
+	cdErr := shellbridge.ChangeDirectory(targetPath)
+	if opts.quiet {
+		// Scripts rely on stdout carrying nothing but the path.
+		fmt.Fprintln(cmd.OutOrStdout(), targetPath)
+		return nil
+	}
+	if err := cdErr; err != nil {
 		fmt.Fprintf(cmd.OutOrStdout(), "Created %s at %s (run `cd %s`)\n", name, targetPath, targetPath)
 	} else {
 		fmt.Fprintf(cmd.OutOrStdout(), "Created %s at %s\n", name, targetPath)
@@ -88,6 +178,48 @@ func runNew(cmd *cobra.Command, opts *newOptions, args []string) error {
 	return nil
 }
 
+// pushNewBranch publishes a freshly created branch so it has an upstream from
+// the start. Without an origin remote there is nowhere to push, which only
+// warrants a warning: the worktree itself is fine.
+func pushNewBranch(cmd *cobra.Command, dir, branch string) error {
+	if url, err := gitutil.RemoteURL(dir, "origin"); err != nil || url == "" {
+		fmt.Fprintln(cmd.ErrOrStderr(), "warning: no origin remote; skipped --push")
+		return nil
+	}
+	if err := pushBranchUpstream(cmd, dir, branch); err != nil {
+		return fmt.Errorf("created %s but %w", dir, err)
+	}
+	return nil
+}
+
+// suffixSourceBranch returns the branch checked out in the current directory,
+// which --suffix both names and bases the new worktree on.
+func suffixSourceBranch() (string, error) {
+	wd, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+	branch, err := gitutil.CurrentBranch(wd)
+	if err != nil {
+		return "", fmt.Errorf("--suffix: %w", err)
+	}
+	if branch == "" || branch == "HEAD" {
+		return "", errors.New("--suffix requires a checked-out branch in the current directory")
+	}
+	return branch, nil
+}
+
+// worktreeNameFromBranch slugifies a branch name into a worktree name that can
+// satisfy namePattern: lowercased, with each run of other characters
+// (including "/") collapsed to "-". wt new, wt branch and wt reopen all name
+// directories this way.
+func worktreeNameFromBranch(branch string) string {
+	slug := nonSlugChars.ReplaceAllString(strings.ToLower(strings.TrimSpace(branch)), "-")
+	return strings.Trim(slug, "-")
+}
+
+var nonSlugChars = regexp.MustCompile(`[^a-z0-9]+`)
+
 func validateWorktreeName(name string) error {
 	if !namePattern.MatchString(name) {
 		return fmt.Errorf("invalid worktree name %q (use lowercase letters, digits, and hyphens)", name)
@@ -98,10 +230,13 @@ func validateWorktreeName(name string) error {
 	return nil
 }
 
-func determineBaseBranch(flag string, proj *project.Project) (string, error) {
+func determineBaseBranch(flag, name string, proj *project.Project) (string, error) {
 	if flag != "" {
 		return flag, nil
 	}
+	if base, ok := proj.Config.New.BaseForName(name); ok {
+		return base, nil
+	}
 	if wd, err := os.Getwd(); err == nil {
 		if branch, berr := gitutil.CurrentBranch(wd); berr == nil {
 			return branch, nil
@@ -116,10 +251,146 @@ func determineBaseBranch(flag string, proj *project.Project) (string, error) {
 	return "", errors.New("unable to determine base branch; pass --base")
 }
 
-func addWorktree(cmd *cobra.Command, proj *project.Project, name, baseBranch, targetPath string) error {
-	args := []string{"-C", proj.DefaultWorktreePath, "worktree", "add", "-b", name, targetPath, baseBranch}
+// fetchLatestBase implements --fetch-base: it refreshes origin/<default> and
+// returns it as the base, reporting the commit the new worktree will start at.
+func fetchLatestBase(cmd *cobra.Command, proj *project.Project, quiet bool) (string, error) {
+	defaultBranch := proj.Config.DefaultBranch
+	if defaultBranch == "" {
+		return "", errors.New("--fetch-base requires default_branch in .wt/config.toml")
+	}
+	if err := gitutil.FetchRemoteDefaultBranch(cmd.Context(), proj.DefaultWorktreePath, "origin", defaultBranch); err != nil {
+		return "", err
+	}
+	base := "origin/" + defaultBranch
+	commit, err := gitutil.Run(proj.DefaultWorktreePath, "rev-parse", "--short", base)
+	if err != nil {
+		return "", fmt.Errorf("resolve %s: %w", base, err)
+	}
+	if !quiet {
+		fmt.Fprintf(cmd.OutOrStdout(), "Using base %s at %s\n", base, commit)
+	}
+	return base, nil
+}
+
+// worktreeHeadCommit resolves --worktree-from to the exact commit the named
+// worktree has checked out, so later moves of its branch don't matter.
+func worktreeHeadCommit(proj *project.Project, name string) (string, error) {
+	worktrees, err := project.ListWorktrees(proj.Root)
+	if err != nil {
+		return "", err
+	}
+	selected, err := selectWorktreesByName(worktrees, []string{name})
+	if err != nil {
+		return "", err
+	}
+	src := selected[0]
+	exists, err := gitutil.HeadExists(src.Path)
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		return "", fmt.Errorf("worktree %s has no commits yet", src.Name)
+	}
+	head, err := gitutil.Run(src.Path, "rev-parse", "HEAD")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(head), nil
+}
+
+// sourceWorktreePath picks the worktree local files are copied from: the one
+// containing the working directory, else the default worktree.
+func sourceWorktreePath(proj *project.Project) string {
+	if wd, err := os.Getwd(); err == nil {
+		if root, err := locateWorktreeRoot(wd, proj.Root); err == nil {
+			return root
+		}
+	}
+	return proj.DefaultWorktreePath
+}
+
+// copyWorktreeFiles copies untracked local files (and directories) listed in
+// [new].copy_files into a freshly created worktree. Missing sources and paths
+// that already exist in the destination are skipped with a warning.
+func copyWorktreeFiles(warn io.Writer, srcRoot, dstRoot string, files []string) error {
+	for _, rel := range files {
+		rel = filepath.Clean(strings.TrimSpace(rel))
+		src := filepath.Join(srcRoot, rel)
+		dst := filepath.Join(dstRoot, rel)
+		if _, err := os.Lstat(src); errors.Is(err, os.ErrNotExist) {
+			fmt.Fprintf(warn, "warning: copy %s: not found in %s; skipping\n", rel, srcRoot)
+			continue
+		} else if err != nil {
+			return err
+		}
+		if _, err := os.Lstat(dst); err == nil {
+			fmt.Fprintf(warn, "warning: copy %s: already exists in new worktree; skipping\n", rel)
+			continue
+		}
+		err := filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			sub, err := filepath.Rel(src, path)
+			if err != nil {
+				return err
+			}
+			target := filepath.Join(dst, sub)
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				return os.MkdirAll(target, info.Mode().Perm()|0o700)
+			}
+			if !info.Mode().IsRegular() {
+				return nil
+			}
+			return copyFile(path, target, info.Mode().Perm())
+		})
+		if err != nil {
+			return fmt.Errorf("copy %s: %w", rel, err)
+		}
+	}
+	return nil
+}
+
+func copyFile(src, dst string, perm fs.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return err
+	}
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+func addWorktree(cmd *cobra.Command, proj *project.Project, branch, baseBranch, targetPath string, quiet, noTrack bool) error {
+	args := []string{"-C", proj.DefaultWorktreePath, "worktree", "add"}
+	if quiet {
+		args = append(args, "--quiet")
+	}
+	if noTrack {
+		// Starting from a remote-tracking ref would otherwise make the new
+		// branch track origin/<default>.
+		args = append(args, "--no-track")
+	}
+	args = append(args, "-b", branch, targetPath, baseBranch)
 	gitCmd := exec.Command("git", args...)
 	gitCmd.Stdout = cmd.OutOrStdout()
+	if quiet {
+		gitCmd.Stdout = cmd.ErrOrStderr()
+	}
 	gitCmd.Stderr = cmd.ErrOrStderr()
 	gitCmd.Stdin = os.Stdin
 	if err := gitCmd.Run(); err != nil {
@@ -128,9 +399,34 @@ func addWorktree(cmd *cobra.Command, proj *project.Project, name, baseBranch, ta
 	return nil
 }
 
+func bootstrapEnv(base []string, extra map[string]string) []string {
+	if len(extra) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(extra))
+	for key := range extra {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	env := append([]string(nil), base...)
+	for _, key := range keys {
+		env = append(env, key+"="+extra[key])
+	}
+	return env
+}
+
 type bootstrapOptions struct {
 	strict bool
 	xtrace bool
+	// timeout bounds the script's runtime; zero means no deadline.
+	timeout time.Duration
+	// env is layered on top of the inherited environment.
+	env map[string]string
+	// quiet routes the script's stdout to stderr so callers can keep stdout clean.
+	quiet bool
+	// stdout and stderr replace the command's streams when set.
+	stdout io.Writer
+	stderr io.Writer
 }
 
 func runBootstrap(cmd *cobra.Command, script, dir string, opts bootstrapOptions) error {
@@ -155,13 +451,46 @@ func runBootstrap(cmd *cobra.Command, script, dir string, opts bootstrapOptions)
 		command = strings.Join(prelude, "\n")
 	}
 
-	run := exec.Command(sh, "-c", command)
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if opts.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.timeout)
+		defer cancel()
+	}
+
+	run := exec.CommandContext(ctx, sh, "-c", command)
+	if opts.timeout > 0 {
+		// Run in a dedicated process group so the deadline also takes down
+		// descendants such as package managers.
+		configureBootstrapProcessGroup(run)
+	}
 	run.Dir = dir
-	run.Stdout = cmd.OutOrStdout()
-	run.Stderr = cmd.ErrOrStderr()
+	run.Env = bootstrapEnv(os.Environ(), opts.env)
+	stdout, stderr := cmd.OutOrStdout(), cmd.ErrOrStderr()
+	if opts.stdout != nil {
+		stdout = opts.stdout
+	}
+	if opts.stderr != nil {
+		stderr = opts.stderr
+	}
+	run.Stdout = stdout
+	if opts.quiet {
+		run.Stdout = stderr
+	}
+	run.Stderr = stderr
 	run.Stdin = os.Stdin
 	if err := run.Run(); err != nil {
+		clearBootstrapMarker(dir)
+		if opts.timeout > 0 && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return fmt.Errorf("bootstrap timed out after %s (see [bootstrap].timeout)", opts.timeout)
+		}
 		return fmt.Errorf("bootstrap failed: %w", err)
 	}
+	if err := writeBootstrapMarker(dir); err != nil {
+		fmt.Fprintf(stderr, "warning: record bootstrap: %v\n", err)
+	}
 	return nil
 }
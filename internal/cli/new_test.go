@@ -0,0 +1,134 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brandonbloom/wt/internal/config"
+	"github.com/brandonbloom/wt/internal/project"
+	"github.com/spf13/cobra"
+)
+
+func TestRunBootstrapTimesOut(t *testing.T) {
+	t.Setenv("SHELL", "/bin/sh")
+	cmd := &cobra.Command{}
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+
+	start := time.Now()
+	err := runBootstrap(cmd, "sleep 30 & wait", t.TempDir(), bootstrapOptions{timeout: 200 * time.Millisecond})
+	if err == nil {
+		t.Fatalf("expected timeout error")
+	}
+	if !strings.Contains(err.Error(), "timed out") {
+		t.Fatalf("error = %v, want timeout", err)
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Fatalf("bootstrap took %s; descendants were not killed", elapsed)
+	}
+}
+
+func TestRunBootstrapPassesEnv(t *testing.T) {
+	t.Setenv("SHELL", "/bin/sh")
+	t.Setenv("WT_INHERITED", "kept")
+	var out strings.Builder
+	cmd := &cobra.Command{}
+	cmd.SetOut(&out)
+	cmd.SetErr(io.Discard)
+
+	err := runBootstrap(cmd, `echo "$NODE_ENV $WT_INHERITED"`, t.TempDir(), bootstrapOptions{
+		env: map[string]string{"NODE_ENV": "development"},
+	})
+	if err != nil {
+		t.Fatalf("runBootstrap: %v", err)
+	}
+	if got := strings.TrimSpace(out.String()); got != "development kept" {
+		t.Fatalf("bootstrap output = %q, want %q", got, "development kept")
+	}
+}
+
+func TestCopyWorktreeFiles(t *testing.T) {
+	src := t.TempDir()
+	dst := t.TempDir()
+	writeFile(t, filepath.Join(src, ".env"), "SECRET=1")
+	if err := os.MkdirAll(filepath.Join(src, ".vscode"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	writeFile(t, filepath.Join(src, ".vscode", "settings.json"), "{}")
+	writeFile(t, filepath.Join(dst, "tracked.txt"), "tracked")
+	writeFile(t, filepath.Join(src, "tracked.txt"), "local")
+
+	var warn strings.Builder
+	err := copyWorktreeFiles(&warn, src, dst, []string{".env", ".vscode/settings.json", "missing.local", "tracked.txt"})
+	if err != nil {
+		t.Fatalf("copyWorktreeFiles: %v", err)
+	}
+
+	for rel, want := range map[string]string{".env": "SECRET=1", ".vscode/settings.json": "{}", "tracked.txt": "tracked"} {
+		data, err := os.ReadFile(filepath.Join(dst, rel))
+		if err != nil {
+			t.Fatalf("read %s: %v", rel, err)
+		}
+		if string(data) != want {
+			t.Fatalf("%s = %q, want %q", rel, data, want)
+		}
+	}
+	if !strings.Contains(warn.String(), "missing.local") || !strings.Contains(warn.String(), "tracked.txt") {
+		t.Fatalf("expected skip warnings, got %q", warn.String())
+	}
+}
+
+func TestDetermineBaseBranchAppliesBaseRules(t *testing.T) {
+	proj := &project.Project{
+		Config: config.Config{
+			DefaultBranch: "develop",
+			New: config.NewBlock{BaseRules: map[string]string{
+				"hotfix-*":      "main",
+				"hotfix-ui-*":   "release",
+				"experiment-??": "sandbox",
+			}},
+		},
+	}
+	cases := []struct {
+		flag, name, want string
+	}{
+		{"", "hotfix-login", "main"},
+		{"", "hotfix-ui-modal", "release"},
+		{"", "experiment-42", "sandbox"},
+		{"other", "hotfix-login", "other"},
+	}
+	for _, tc := range cases {
+		got, err := determineBaseBranch(tc.flag, tc.name, proj)
+		if err != nil {
+			t.Fatalf("determineBaseBranch(%q, %q): %v", tc.flag, tc.name, err)
+		}
+		if got != tc.want {
+			t.Fatalf("determineBaseBranch(%q, %q) = %q, want %q", tc.flag, tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestWorktreeNameFromBranch(t *testing.T) {
+	cases := map[string]string{
+		"feature/login":        "feature-login",
+		"Users/Ann/Fix_Bug.2":  "users-ann-fix-bug-2",
+		"--release//v1.2--":    "release-v1-2",
+		"hotfix/ui/modal-menu": "hotfix-ui-modal-menu",
+		"brandon/fix-login":    "brandon-fix-login",
+		"  spaced name ":       "spaced-name",
+		"-/weird/-":            "weird",
+	}
+	for branch, want := range cases {
+		got := worktreeNameFromBranch(branch)
+		if got != want {
+			t.Fatalf("worktreeNameFromBranch(%q) = %q, want %q", branch, got, want)
+		}
+		if err := validateWorktreeName(got); err != nil {
+			t.Fatalf("slug %q is not a valid worktree name: %v", got, err)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/brandonbloom/wt/internal/gitutil"
+	"github.com/brandonbloom/wt/internal/project"
+	"github.com/spf13/cobra"
+)
+
+type prCreateOptions struct {
+	draft bool
+}
+
+func newPRCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pr",
+		Short: "Work with the current worktree's pull request",
+		Args:  cobra.NoArgs,
+	}
+	cmd.AddCommand(newPRCreateCommand())
+	return cmd
+}
+
+func newPRCreateCommand() *cobra.Command {
+	opts := &prCreateOptions{}
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Push the current worktree's branch and open a pull request",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runPRCreate(cmd, opts)
+		},
+	}
+	cmd.Flags().BoolVar(&opts.draft, "draft", false, "open the pull request as a draft")
+	return cmd
+}
+
+func runPRCreate(cmd *cobra.Command, opts *prCreateOptions) error {
+	proj, err := loadProjectFromWD()
+	if err != nil {
+		return err
+	}
+	if err := requireGh(proj); err != nil {
+		return err
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	worktrees, err := project.ListWorktrees(proj.Root)
+	if err != nil {
+		return err
+	}
+	wt := findWorktreeContaining(worktrees, wd)
+	if wt == nil {
+		return errors.New("not inside a worktree; cd into the worktree whose branch should get a pull request")
+	}
+	branch, err := gitutil.CurrentBranch(wt.Path)
+	if err != nil {
+		return err
+	}
+	switch branch {
+	case "", "HEAD":
+		return fmt.Errorf("worktree %s has a detached HEAD; check out a branch first", wt.Name)
+	case proj.Config.DefaultBranch:
+		return fmt.Errorf("%s is the default branch; pull requests are opened from feature worktrees", branch)
+	}
+
+	if err := pushBranchUpstream(cmd, wt.Path, branch); err != nil {
+		return err
+	}
+
+	ghArgs := []string{"pr", "create", "--fill", "--head", branch}
+	if opts.draft {
+		ghArgs = append(ghArgs, "--draft")
+	}
+	gh := exec.CommandContext(cmd.Context(), "gh", ghArgs...)
+	gh.Dir = wt.Path
+	var stdout bytes.Buffer
+	gh.Stdout = &stdout
+	gh.Stderr = cmd.ErrOrStderr()
+	gh.Stdin = os.Stdin
+	if err := gh.Run(); err != nil {
+		return fmt.Errorf("gh pr create failed: %w", err)
+	}
+
+	url := lastNonEmptyLine(stdout.String())
+	if url == "" {
+		fmt.Fprintf(cmd.OutOrStdout(), "Opened pull request for %s\n", branch)
+		return nil
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Opened pull request for %s: %s\n", branch, url)
+	return nil
+}
+
+// pushBranchUpstream runs `git push -u origin <branch>` from dir, streaming
+// git's progress to stderr so stdout stays free for results.
+func pushBranchUpstream(cmd *cobra.Command, dir, branch string) error {
+	push := exec.CommandContext(cmd.Context(), "git", "-C", dir, "push", "-u", "origin", branch)
+	push.Stdout = cmd.ErrOrStderr()
+	push.Stderr = cmd.ErrOrStderr()
+	push.Stdin = os.Stdin
+	if err := push.Run(); err != nil {
+		return fmt.Errorf("git push failed: %w", err)
+	}
+	return nil
+}
+
+// lastNonEmptyLine picks the PR URL out of gh's output, which ends with it.
+func lastNonEmptyLine(text string) string {
+	lines := strings.Split(strings.TrimSpace(text), "\n")
+	for i := len(lines) - 1; i >= 0; i-- {
+		if line := strings.TrimSpace(lines[i]); line != "" {
+			return line
+		}
+	}
+	return ""
+}
@@ -11,19 +11,22 @@ import (
 )
 
 type prGraphQLNode struct {
-	Number      int    `json:"number"`
-	State       string `json:"state"`
-	IsDraft     bool   `json:"isDraft"`
-	UpdatedAt   string `json:"updatedAt"`
-	URL         string `json:"url"`
-	HeadRefName string `json:"headRefName"`
+	Number           int    `json:"number"`
+	State            string `json:"state"`
+	IsDraft          bool   `json:"isDraft"`
+	UpdatedAt        string `json:"updatedAt"`
+	URL              string `json:"url"`
+	HeadRefName      string `json:"headRefName"`
+	ReviewDecision   string `json:"reviewDecision"`
+	Mergeable        string `json:"mergeable"`
+	MergeStateStatus string `json:"mergeStateStatus"`
 }
 
 type prGraphQLConnection struct {
 	Nodes []prGraphQLNode `json:"nodes"`
 }
 
-func buildPullRequestsGraphQLQuery(branches []string) (string, map[string]string, map[string]string) {
+func buildPullRequestsGraphQLQuery(branches []string, limit int) (string, map[string]string, map[string]string) {
 	unique := make([]string, 0, len(branches))
 	seen := make(map[string]bool, len(branches))
 	for _, branch := range branches {
@@ -56,23 +59,23 @@ func buildPullRequestsGraphQLQuery(branches []string) (string, map[string]string
 		varValues[varName] = branch
 
 		fmt.Fprintf(&b, `
-  %s: pullRequests(headRefName:$%s, states:[OPEN,CLOSED,MERGED], first:5, orderBy:{field:UPDATED_AT, direction:DESC}) {
-    nodes { number state isDraft updatedAt url headRefName }
-  }`, alias, varName)
+  %s: pullRequests(headRefName:$%s, states:[OPEN,CLOSED,MERGED], first:%d, orderBy:{field:UPDATED_AT, direction:DESC}) {
+    nodes { number state isDraft updatedAt url headRefName reviewDecision mergeable mergeStateStatus }
+  }`, alias, varName, limit)
 	}
 	b.WriteString("\n} }")
 
 	return b.String(), aliasToBranch, varValues
 }
 
-func queryPullRequestsGraphQL(ctx context.Context, workdir string, repo *githubRepo, branches []string) (map[string][]pullRequestInfo, error) {
+func queryPullRequestsGraphQL(ctx context.Context, workdir string, repo *forgeRepo, branches []string, limit int) (map[string][]pullRequestInfo, error) {
 	if repo == nil {
 		return nil, fmt.Errorf("repo unavailable")
 	}
 	region := trace.StartRegion(ctx, "gh pr batch")
 	defer region.End()
 
-	query, aliasToBranch, varValues := buildPullRequestsGraphQLQuery(branches)
+	query, aliasToBranch, varValues := buildPullRequestsGraphQLQuery(branches, limit)
 	if len(aliasToBranch) == 0 {
 		return map[string][]pullRequestInfo{}, nil
 	}
@@ -119,11 +122,14 @@ func parsePullRequestsGraphQLResponse(data []byte, aliasToBranch map[string]stri
 		for _, node := range conn.Nodes {
 			t, _ := time.Parse(time.RFC3339, node.UpdatedAt)
 			prs = append(prs, pullRequestInfo{
-				Number:    node.Number,
-				State:     node.State,
-				IsDraft:   node.IsDraft,
-				UpdatedAt: t,
-				URL:       node.URL,
+				Number:           node.Number,
+				State:            node.State,
+				IsDraft:          node.IsDraft,
+				UpdatedAt:        t,
+				URL:              node.URL,
+				ReviewDecision:   node.ReviewDecision,
+				Mergeable:        node.Mergeable,
+				MergeStateStatus: node.MergeStateStatus,
 			})
 		}
 		out[branch] = prs
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"strings"
 	"testing"
 	"time"
 )
@@ -17,7 +18,10 @@ func TestParsePullRequestsGraphQLResponse(t *testing.T) {
             "isDraft": false,
             "updatedAt": "2000-01-02T00:00:00Z",
             "url": "https://example.com/pr/42",
-            "headRefName": "demo-branch"
+            "headRefName": "demo-branch",
+            "reviewDecision": "CHANGES_REQUESTED",
+            "mergeable": "CONFLICTING",
+            "mergeStateStatus": "DIRTY"
           }
         ]
       },
@@ -40,6 +44,7 @@ func TestParsePullRequestsGraphQLResponse(t *testing.T) {
 	aliasToBranch := map[string]string{
 		"pr0": "demo-branch",
 		"pr1": "merged-branch",
+		"pr2": "gone-branch",
 	}
 
 	got, err := parsePullRequestsGraphQLResponse(data, aliasToBranch)
@@ -54,8 +59,35 @@ func TestParsePullRequestsGraphQLResponse(t *testing.T) {
 		t.Fatalf("merged-branch = %#v, want PR #99", got["merged-branch"])
 	}
 
+	if prs, ok := got["gone-branch"]; !ok || prs != nil {
+		t.Fatalf("gone-branch = %#v (present %v), want a nil entry", prs, ok)
+	}
+
+	demo := got["demo-branch"][0]
 	wantTime, _ := time.Parse(time.RFC3339, "2000-01-02T00:00:00Z")
-	if got["demo-branch"][0].UpdatedAt != wantTime {
-		t.Fatalf("updatedAt = %v, want %v", got["demo-branch"][0].UpdatedAt, wantTime)
+	if demo.UpdatedAt != wantTime {
+		t.Fatalf("updatedAt = %v, want %v", demo.UpdatedAt, wantTime)
+	}
+	if demo.ReviewDecision != "CHANGES_REQUESTED" {
+		t.Fatalf("reviewDecision = %q, want CHANGES_REQUESTED", demo.ReviewDecision)
+	}
+	if demo.Mergeable != "CONFLICTING" || demo.MergeStateStatus != "DIRTY" {
+		t.Fatalf("mergeable = %q, mergeStateStatus = %q, want CONFLICTING, DIRTY", demo.Mergeable, demo.MergeStateStatus)
+	}
+}
+
+func TestBuildPullRequestsGraphQLQueryUsesLimit(t *testing.T) {
+	query, aliasToBranch, varValues := buildPullRequestsGraphQLQuery([]string{"b", "a", "b", " "}, 7)
+	if len(aliasToBranch) != 2 || aliasToBranch["pr0"] != "a" || aliasToBranch["pr1"] != "b" {
+		t.Fatalf("aliasToBranch = %v, want pr0=a, pr1=b", aliasToBranch)
+	}
+	if varValues["b0"] != "a" || varValues["b1"] != "b" {
+		t.Fatalf("varValues = %v, want b0=a, b1=b", varValues)
+	}
+	if got := strings.Count(query, "first:7,"); got != 2 {
+		t.Fatalf("query has %d first:7 arguments, want 2:\n%s", got, query)
+	}
+	if !strings.Contains(query, "reviewDecision mergeable mergeStateStatus") {
+		t.Fatalf("query does not request review and merge state:\n%s", query)
 	}
 }
@@ -2,7 +2,11 @@ package cli
 
 import (
 	"context"
+	"errors"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 )
 
 func TestMarkPRInterrupted(t *testing.T) {
@@ -51,10 +55,38 @@ func TestFetchPullRequestStatuses_SkipsStatusesWithErrors(t *testing.T) {
 		},
 	}
 
-	if err := fetchPullRequestStatuses(context.Background(), nil, nil, statuses, workflowExpectations{PRsExpected: true}, nil); err != nil {
+	if err := fetchPullRequestStatuses(context.Background(), nil, nil, statuses, workflowExpectations{PRsExpected: true}, 5, nil); err != nil {
 		t.Fatalf("fetchPullRequestStatuses returned error: %v", err)
 	}
 	if got := statuses[0].PRStatus; got != "error: git failed" {
 		t.Fatalf("PRStatus = %q, want %q", got, "error: git failed")
 	}
 }
+
+func TestFetchPullRequestStatuses_MarksTimeouts(t *testing.T) {
+	binDir := t.TempDir()
+	script := "#!/bin/sh\nexec sleep 30\n"
+	if err := os.WriteFile(filepath.Join(binDir, "gh"), []byte(script), 0o755); err != nil {
+		t.Fatalf("write fake gh: %v", err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	t.Setenv("WT_TEST_SERIAL_FETCH", "")
+
+	statuses := []*worktreeStatus{{
+		Name:           "slow",
+		Branch:         "slow",
+		Path:           t.TempDir(),
+		PRStatus:       prLoadingLabel,
+		HasPendingWork: true,
+	}}
+
+	ctx, cancel := ghPhaseContext(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	err := fetchPullRequestStatuses(ctx, nil, nil, statuses, workflowExpectations{PRsExpected: true}, 5, nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want deadline exceeded", err)
+	}
+	if got := statuses[0].PRStatus; got != prTimeoutLabel {
+		t.Fatalf("PRStatus = %q, want %q", got, prTimeoutLabel)
+	}
+}
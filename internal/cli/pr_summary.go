@@ -2,12 +2,12 @@ package cli
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"os/exec"
 	"runtime/trace"
 	"strings"
 	"time"
+
+	"github.com/brandonbloom/wt/internal/forge"
 )
 
 type pullRequestInfo struct {
@@ -16,6 +16,12 @@ type pullRequestInfo struct {
 	IsDraft   bool
 	UpdatedAt time.Time
 	URL       string
+	// ReviewDecision is GitHub's aggregate review state, e.g. APPROVED.
+	ReviewDecision string
+	// Mergeable and MergeStateStatus are GitHub's mergeability fields, e.g.
+	// CONFLICTING and DIRTY.
+	Mergeable        string
+	MergeStateStatus string
 }
 
 func (pr pullRequestInfo) Open() bool {
@@ -23,57 +29,34 @@ func (pr pullRequestInfo) Open() bool {
 	return state == "open"
 }
 
-func queryPullRequests(ctx context.Context, dir, branch string) ([]pullRequestInfo, error) {
+// Conflicting reports whether an open PR cannot merge because of conflicts
+// with its base branch.
+func (pr pullRequestInfo) Conflicting() bool {
+	return pr.Open() && (strings.EqualFold(pr.Mergeable, "CONFLICTING") || strings.EqualFold(pr.MergeStateStatus, "DIRTY"))
+}
+
+const prConflictsMarker = "⚠ conflicts"
+
+// queryPullRequests lists a branch's pull requests through the repo's forge.
+// A nil repo falls back to gh pr list, letting gh infer the repository from
+// dir.
+func queryPullRequests(ctx context.Context, repo *forgeRepo, dir, branch string, limit int) ([]pullRequestInfo, error) {
 	if branch == "" {
 		return nil, nil
 	}
-	region := trace.StartRegion(ctx, "gh pr list")
+	region := trace.StartRegion(ctx, "pr list")
 	defer region.End()
-	cmd := exec.CommandContext(
-		ctx,
-		"gh",
-		"pr",
-		"list",
-		"--head", branch,
-		"--state", "all",
-		"--limit", "5",
-		"--json", "number,state,isDraft,updatedAt,url",
-	)
-	cmd.Dir = dir
-	var stdout, stderr strings.Builder
-	cmd.Stdout = &stdout
-	cmd.Stderr = &stderr
-	if err := cmd.Run(); err != nil {
-		msg := strings.TrimSpace(stderr.String())
-		if msg == "" {
-			msg = err.Error()
-		}
-		return nil, fmt.Errorf("gh pr list: %s", msg)
-	}
-
-	parseRegion := trace.StartRegion(ctx, "parse pr json")
-	defer parseRegion.End()
-	var raw []struct {
-		Number    int    `json:"number"`
-		State     string `json:"state"`
-		IsDraft   bool   `json:"isDraft"`
-		UpdatedAt string `json:"updatedAt"`
-		URL       string `json:"url"`
+	f := forge.NewGitHub(forge.Repo{})
+	if repo != nil && repo.Forge != nil {
+		f = repo.Forge
 	}
-	if err := json.Unmarshal([]byte(stdout.String()), &raw); err != nil {
+	raw, err := f.ListPullRequests(ctx, dir, branch, limit)
+	if err != nil {
 		return nil, err
 	}
-
 	prs := make([]pullRequestInfo, 0, len(raw))
 	for _, pr := range raw {
-		t, _ := time.Parse(time.RFC3339, pr.UpdatedAt)
-		prs = append(prs, pullRequestInfo{
-			Number:    pr.Number,
-			State:     pr.State,
-			IsDraft:   pr.IsDraft,
-			UpdatedAt: t,
-			URL:       pr.URL,
-		})
+		prs = append(prs, pullRequestInfo(pr))
 	}
 	return prs, nil
 }
@@ -87,6 +70,9 @@ type prSummary struct {
 type prContext struct {
 	HasPendingWork   bool
 	HasUniqueCommits bool
+	// Truncated means the lookup hit the configured PR limit, so more pull
+	// requests may exist than were returned.
+	Truncated bool
 }
 
 func summarizePullRequestState(ctx prContext, prs []pullRequestInfo, workflow workflowExpectations) prSummary {
@@ -101,6 +87,9 @@ func summarizePullRequestState(ctx prContext, prs []pullRequestInfo, workflow wo
 			return prSummary{Operation: text, Column: text}
 		}
 		text := formatMultiplePRs(active)
+		if ctx.Truncated {
+			text += " (+more)"
+		}
 		return prSummary{Operation: text, Column: text}
 	}
 	if !ctx.HasUniqueCommits {
@@ -137,6 +126,9 @@ func openPullRequests(prs []pullRequestInfo) []pullRequestInfo {
 
 func formatSinglePR(pr pullRequestInfo) string {
 	state := formatPRState(pr)
+	if pr.Conflicting() {
+		return fmt.Sprintf("#%d %s %s", pr.Number, state, prConflictsMarker)
+	}
 	return fmt.Sprintf("#%d %s", pr.Number, state)
 }
 
@@ -153,8 +145,17 @@ func formatMultiplePRs(prs []pullRequestInfo) string {
 
 func formatPRState(pr pullRequestInfo) string {
 	state := strings.ToLower(pr.State)
-	if pr.IsDraft && state == "open" {
+	if state != "open" {
+		return state
+	}
+	if pr.IsDraft {
 		return "draft"
 	}
+	switch strings.ToUpper(pr.ReviewDecision) {
+	case "APPROVED":
+		return "approved"
+	case "CHANGES_REQUESTED":
+		return "changes"
+	}
 	return state
 }
@@ -37,3 +37,44 @@ func TestSummarizePullRequestState_ShowsNoPRWhenPendingWorkAndPRsExpected(t *tes
 		t.Fatalf("Reason = %q, want %q", summary.Reason, "No PR")
 	}
 }
+
+func TestSummarizePullRequestState_FlagsTruncatedMultiplePRs(t *testing.T) {
+	prs := []pullRequestInfo{
+		{Number: 3, State: "OPEN"},
+		{Number: 2, State: "OPEN"},
+	}
+	summary := summarizePullRequestState(
+		prContext{
+			HasPendingWork:   true,
+			HasUniqueCommits: true,
+			Truncated:        true,
+		},
+		prs,
+		workflowExpectations{PRsExpected: true},
+	)
+
+	if want := "PR #3, #2 multiple (+more)"; summary.Column != want {
+		t.Fatalf("Column = %q, want %q", summary.Column, want)
+	}
+}
+
+func TestFormatSinglePRShowsReviewDecision(t *testing.T) {
+	cases := []struct {
+		pr   pullRequestInfo
+		want string
+	}{
+		{pullRequestInfo{Number: 42, State: "OPEN", ReviewDecision: "APPROVED"}, "#42 approved"},
+		{pullRequestInfo{Number: 42, State: "OPEN", ReviewDecision: "CHANGES_REQUESTED"}, "#42 changes"},
+		{pullRequestInfo{Number: 42, State: "OPEN", ReviewDecision: "REVIEW_REQUIRED"}, "#42 open"},
+		{pullRequestInfo{Number: 42, State: "OPEN", IsDraft: true, ReviewDecision: "APPROVED"}, "#42 draft"},
+		{pullRequestInfo{Number: 42, State: "MERGED", ReviewDecision: "APPROVED"}, "#42 merged"},
+		{pullRequestInfo{Number: 42, State: "OPEN", ReviewDecision: "APPROVED", Mergeable: "CONFLICTING"}, "#42 approved ⚠ conflicts"},
+		{pullRequestInfo{Number: 42, State: "OPEN", MergeStateStatus: "DIRTY"}, "#42 open ⚠ conflicts"},
+		{pullRequestInfo{Number: 42, State: "MERGED", Mergeable: "CONFLICTING"}, "#42 merged"},
+	}
+	for _, tc := range cases {
+		if got := formatSinglePR(tc.pr); got != tc.want {
+			t.Fatalf("formatSinglePR(%+v) = %q, want %q", tc.pr, got, tc.want)
+		}
+	}
+}
@@ -6,15 +6,17 @@ import (
 	"path/filepath"
 	"sort"
 	"strings"
+	"sync"
 
 	"github.com/brandonbloom/wt/internal/processes"
 	"github.com/brandonbloom/wt/internal/project"
 )
 
 var (
-	listProcesses     = processes.List
-	currentProcessPID = os.Getpid()
-	parentProcessPID  = os.Getppid()
+	listProcesses      = processes.List
+	listProcessesUnder = processes.ListUnder
+	currentProcessPID  = os.Getpid()
+	parentProcessPID   = os.Getppid()
 )
 
 func attachProcessesToStatuses(statuses []*worktreeStatus, worktrees []project.Worktree) error {
@@ -53,7 +55,12 @@ func attachProcessesToCandidates(candidates []*tidyCandidate) error {
 }
 
 func detectWorktreeProcesses(worktrees []project.Worktree) (map[string][]processes.Process, bool, error) {
-	procs, err := listProcesses()
+	canonicalRoots := make([]string, len(worktrees))
+	for i, wt := range worktrees {
+		canonicalRoots[i] = canonicalizePath(wt.Path)
+	}
+
+	procs, err := listProcessesUnder(canonicalRoots)
 	if errors.Is(err, processes.ErrUnsupported) {
 		return nil, false, nil
 	}
@@ -61,11 +68,6 @@ func detectWorktreeProcesses(worktrees []project.Worktree) (map[string][]process
 		return nil, true, err
 	}
 
-	canonicalRoots := make([]string, len(worktrees))
-	for i, wt := range worktrees {
-		canonicalRoots[i] = canonicalizePath(wt.Path)
-	}
-
 	result := make(map[string][]processes.Process, len(worktrees))
 	for _, proc := range procs {
 		cwd := normalizeProcessCWD(proc.CWD)
@@ -103,6 +105,20 @@ func detectWorktreeProcesses(worktrees []project.Worktree) (map[string][]process
 	return result, true, nil
 }
 
+// canonicalPathCache memoizes canonicalizePath for one command invocation;
+// the root command installs a fresh one before each run and drops it after.
+// It is nil outside an invocation, in which case nothing is cached.
+var canonicalPathCache *pathCache
+
+type pathCache struct {
+	mu       sync.Mutex
+	resolved map[string]string
+}
+
+func newPathCache() *pathCache {
+	return &pathCache{resolved: make(map[string]string)}
+}
+
 func canonicalizePath(path string) string {
 	if path == "" {
 		return ""
@@ -112,10 +128,27 @@ func canonicalizePath(path string) string {
 			path = abs
 		}
 	}
-	if resolved, err := filepath.EvalSymlinks(path); err == nil {
-		path = resolved
+	cache := canonicalPathCache
+	if cache != nil {
+		cache.mu.Lock()
+		resolved, ok := cache.resolved[path]
+		cache.mu.Unlock()
+		if ok {
+			return resolved
+		}
+	}
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		// Not cached: the path may be created later in the same run.
+		return filepath.Clean(path)
+	}
+	resolved = filepath.Clean(resolved)
+	if cache != nil {
+		cache.mu.Lock()
+		cache.resolved[path] = resolved
+		cache.mu.Unlock()
 	}
-	return filepath.Clean(path)
+	return resolved
 }
 
 func normalizeProcessCWD(path string) string {
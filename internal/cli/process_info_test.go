@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/brandonbloom/wt/internal/processes"
@@ -44,3 +46,41 @@ func TestPruneProcessList(t *testing.T) {
 		t.Fatalf("expected rails and zsh to remain, got %#v", filtered)
 	}
 }
+
+func TestCanonicalizePathCachesPerInvocation(t *testing.T) {
+	dir := t.TempDir()
+	first := filepath.Join(dir, "first")
+	second := filepath.Join(dir, "second")
+	for _, d := range []string{first, second} {
+		if err := os.Mkdir(d, 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	link := filepath.Join(dir, "link")
+	if err := os.Symlink(first, link); err != nil {
+		t.Fatal(err)
+	}
+	want := canonicalizePath(first)
+
+	orig := canonicalPathCache
+	canonicalPathCache = newPathCache()
+	defer func() { canonicalPathCache = orig }()
+
+	if got := canonicalizePath(link); got != want {
+		t.Fatalf("canonicalizePath(link) = %q, want %q", got, want)
+	}
+	if err := os.Remove(link); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(second, link); err != nil {
+		t.Fatal(err)
+	}
+	if got := canonicalizePath(link); got != want {
+		t.Fatalf("cached canonicalizePath(link) = %q, want %q", got, want)
+	}
+
+	canonicalPathCache = nil
+	if got := canonicalizePath(link); got != canonicalizePath(second) {
+		t.Fatalf("uncached canonicalizePath(link) = %q, want %q", got, canonicalizePath(second))
+	}
+}
@@ -24,6 +24,36 @@ type killSettings struct {
 	Signal      syscall.Signal
 	SignalLabel string
 	Timeout     time.Duration
+	// Escalate re-signals survivors with EscalateSignal after Timeout and
+	// waits another Timeout before giving up.
+	Escalate            bool
+	EscalateSignal      syscall.Signal
+	EscalateSignalLabel string
+}
+
+// enableEscalation turns on --escalate using spec (a signal name or number,
+// typically process.escalate_signal); an empty spec means SIGKILL.
+func (s *killSettings) enableEscalation(spec string) error {
+	sig := syscall.Signal(syscall.SIGKILL)
+	if strings.TrimSpace(spec) != "" {
+		parsed, err := parseSignal(spec)
+		if err != nil {
+			return fmt.Errorf("invalid process.escalate_signal: %w", err)
+		}
+		sig = parsed
+	}
+	s.Escalate = true
+	s.EscalateSignal = sig
+	s.EscalateSignalLabel = describeSignal(sig)
+	return nil
+}
+
+// killHooks lets callers observe terminateWorktreeProcesses. Both fields are
+// optional.
+type killHooks struct {
+	Progress waitProgressFunc
+	// Escalating is called before survivors are re-signaled under --escalate.
+	Escalating func(remaining []processes.Process)
 }
 
 func resolveKillSettings(signalSpec string, timeoutSpec string, defaultTimeout time.Duration) (killSettings, error) {
@@ -114,7 +144,7 @@ func newProcessTerminator() processTerminator {
 	return realProcessTerminator{}
 }
 
-func terminateWorktreeProcesses(ctx context.Context, wt project.Worktree, procs []processes.Process, settings killSettings, term processTerminator) error {
+func terminateWorktreeProcesses(ctx context.Context, wt project.Worktree, procs []processes.Process, settings killSettings, term processTerminator, hooks killHooks) error {
 	var errs error
 	for _, proc := range procs {
 		if err := term.Terminate(proc, settings.Signal); err != nil {
@@ -124,22 +154,70 @@ func terminateWorktreeProcesses(ctx context.Context, wt project.Worktree, procs
 	if errs != nil {
 		return errs
 	}
-	remaining, err := waitForProcessExit(ctx, wt, settings.Timeout)
+	remaining, err := waitForProcessExit(ctx, wt, settings.Timeout, hooks.Progress)
 	if err != nil {
 		return err
 	}
-	if len(remaining) > 0 {
-		summary := summarizeProcesses(remaining, defaultProcessSummaryLimit)
-		if summary == "-" {
-			summary = fmt.Sprintf("%d process(es)", len(remaining))
+	waited := settings.Timeout
+	if len(remaining) > 0 && settings.Escalate && settings.EscalateSignal != settings.Signal {
+		if hooks.Escalating != nil {
+			hooks.Escalating(remaining)
+		}
+		for _, proc := range remaining {
+			if err := term.Terminate(proc, settings.EscalateSignal); err != nil {
+				errs = errors.Join(errs, fmt.Errorf("%s (%d): %w", processCommandLabel(proc.Command), proc.PID, err))
+			}
 		}
-		return fmt.Errorf("processes still running after %s: %s", settings.Timeout, summary)
+		if errs != nil {
+			return errs
+		}
+		remaining, err = waitForProcessExit(ctx, wt, settings.Timeout, hooks.Progress)
+		if err != nil {
+			return err
+		}
+		waited += settings.Timeout
+	}
+	if len(remaining) > 0 {
+		return &survivorsError{Waited: waited, Survivors: remaining}
 	}
 	return nil
 }
 
-func waitForProcessExit(ctx context.Context, wt project.Worktree, timeout time.Duration) ([]processes.Process, error) {
-	deadline := time.Now().Add(timeout)
+// survivorsError reports processes that outlived the kill timeout. Survivors
+// come from the final poll, so their State is current: a stopped process
+// ignores SIGTERM until continued, and one in disk sleep may never exit.
+type survivorsError struct {
+	Waited    time.Duration
+	Survivors []processes.Process
+}
+
+func (e *survivorsError) Error() string {
+	summary := summarizeProcesses(e.Survivors, defaultProcessSummaryLimit)
+	if summary == "-" {
+		summary = fmt.Sprintf("%d process(es)", len(e.Survivors))
+	}
+	return fmt.Sprintf("processes still running after %s: %s", e.Waited, summary)
+}
+
+// survivorLabel is a survivor's listing label followed by its state.
+func survivorLabel(proc processes.Process, now time.Time) string {
+	state := proc.State
+	if state == "" {
+		state = "unknown"
+	}
+	return fmt.Sprintf("%s: %s", processListingLabel(proc, now), state)
+}
+
+// waitProgressFunc is called on every poll while waitForProcessExit is still
+// waiting, with the processes that remain and how long it has waited so far.
+type waitProgressFunc func(remaining []processes.Process, elapsed time.Duration)
+
+// waitForProcessExit polls until the worktree has no processes or timeout
+// passes. Survivors are returned as re-listed by the last poll, so their
+// metadata (notably State) reflects how they responded to the signal.
+func waitForProcessExit(ctx context.Context, wt project.Worktree, timeout time.Duration, progress waitProgressFunc) ([]processes.Process, error) {
+	start := time.Now()
+	deadline := start.Add(timeout)
 	for {
 		select {
 		case <-ctx.Done():
@@ -160,6 +238,9 @@ func waitForProcessExit(ctx context.Context, wt project.Worktree, timeout time.D
 		if time.Now().After(deadline) {
 			return list, nil
 		}
+		if progress != nil {
+			progress(list, time.Since(start))
+		}
 		time.Sleep(100 * time.Millisecond)
 	}
 }
@@ -5,8 +5,10 @@ import (
 	"path/filepath"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/brandonbloom/wt/internal/processes"
+	"github.com/brandonbloom/wt/internal/timefmt"
 )
 
 const (
@@ -106,6 +108,16 @@ func processCommandLabel(cmd string) string {
 	return cmd
 }
 
+// processListingLabel renders a process for the kill listings as
+// "server (1111)", adding when it started when the platform reports it so a
+// fresh dev server can be told apart from one that has run for days.
+func processListingLabel(proc processes.Process, now time.Time) string {
+	if proc.StartedAt.IsZero() {
+		return fmt.Sprintf("%s (%d)", processCommandLabel(proc.Command), proc.PID)
+	}
+	return fmt.Sprintf("%s (%d, started %s)", processCommandLabel(proc.Command), proc.PID, timefmt.Relative(proc.StartedAt, now))
+}
+
 func joinPIDs(pids []int) string {
 	var b strings.Builder
 	for i, pid := range pids {
@@ -0,0 +1,67 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/brandonbloom/wt/internal/gitutil"
+	"github.com/spf13/cobra"
+)
+
+const archiveRefPrefix = "refs/wt-archive/"
+
+func newReopenCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reopen <branch>",
+		Short: "Recreate a worktree for an existing (or archived) branch",
+		Args:  cobra.ExactArgs(1),
+		RunE:  runReopen,
+	}
+	return cmd
+}
+
+func runReopen(cmd *cobra.Command, args []string) error {
+	proj, err := loadProjectFromWD()
+	if err != nil {
+		return err
+	}
+
+	branch := strings.TrimSpace(args[0])
+	if branch == "" {
+		return errors.New("branch name required")
+	}
+	targetPath, err := prepareBranchWorktree(proj, branch)
+	if err != nil {
+		return err
+	}
+
+	restored, err := restoreArchivedBranch(proj.DefaultWorktreePath, branch)
+	if err != nil {
+		return err
+	}
+	if restored {
+		fmt.Fprintf(cmd.OutOrStdout(), "Restored branch %s from %s%s\n", branch, archiveRefPrefix, branch)
+	}
+
+	return openBranchWorktree(cmd, proj, branch, targetPath, "Reopened")
+}
+
+// restoreArchivedBranch ensures refs/heads/<branch> exists, recreating it from
+// refs/wt-archive/<branch> when only the archived copy remains.
+func restoreArchivedBranch(repoDir, branch string) (bool, error) {
+	if gitutil.BranchExists(repoDir, branch) {
+		return false, nil
+	}
+	archiveRef := archiveRefPrefix + branch
+	if !gitutil.RefExists(repoDir, archiveRef) {
+		return false, fmt.Errorf("no local branch %s (and nothing archived under %s)", branch, archiveRef)
+	}
+	if _, err := gitutil.Run(repoDir, "branch", branch, archiveRef); err != nil {
+		return false, err
+	}
+	if _, err := gitutil.Run(repoDir, "update-ref", "-d", archiveRef); err != nil {
+		return true, err
+	}
+	return true, nil
+}
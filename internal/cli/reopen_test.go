@@ -0,0 +1,35 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/brandonbloom/wt/internal/gitutil"
+)
+
+func TestRestoreArchivedBranch(t *testing.T) {
+	repo := initTempRepo(t)
+	gitCmd(t, repo, "update-ref", "refs/wt-archive/old-feature", "HEAD")
+
+	restored, err := restoreArchivedBranch(repo, "old-feature")
+	if err != nil {
+		t.Fatalf("restoreArchivedBranch: %v", err)
+	}
+	if !restored {
+		t.Fatalf("expected branch to be restored from archive")
+	}
+	if !gitutil.BranchExists(repo, "old-feature") {
+		t.Fatalf("expected refs/heads/old-feature to exist")
+	}
+	if gitutil.RefExists(repo, "refs/wt-archive/old-feature") {
+		t.Fatalf("expected archive ref to be removed")
+	}
+
+	restored, err = restoreArchivedBranch(repo, "old-feature")
+	if err != nil || restored {
+		t.Fatalf("existing branch should be used as-is, got restored=%v err=%v", restored, err)
+	}
+
+	if _, err := restoreArchivedBranch(repo, "missing"); err == nil {
+		t.Fatalf("expected error for unknown branch")
+	}
+}
@@ -3,6 +3,7 @@ package cli
 import (
 	"bufio"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -21,6 +22,12 @@ import (
 type rmOptions struct {
 	dryRun bool
 	force  bool
+	yes    bool
+	// keepBranch removes only the worktree, leaving local and remote
+	// branches in place.
+	keepBranch bool
+	// json switches the --dry-run plan to machine-readable output.
+	json bool
 }
 
 func newRmCommand() *cobra.Command {
@@ -35,21 +42,29 @@ func newRmCommand() *cobra.Command {
 	}
 	cmd.Flags().BoolVarP(&opts.dryRun, "dry-run", "n", false, "show actions without deleting anything")
 	cmd.Flags().BoolVarP(&opts.force, "force", "f", false, "skip the confirmation prompt for gray worktrees")
+	cmd.Flags().BoolVarP(&opts.yes, "yes", "y", false, "answer yes to the gray-worktree prompt without overriding blocks")
+	cmd.Flags().BoolVar(&opts.keepBranch, "keep-branch", false, "remove only the worktree directory; keep the local and remote branch")
+	cmd.Flags().BoolVar(&opts.json, "json", false, "with --dry-run, print the plan as JSON")
 	return cmd
 }
 
 func runRm(cmd *cobra.Command, opts *rmOptions, args []string) error {
-	if _, err := exec.LookPath("gh"); err != nil {
-		return fmt.Errorf("gh CLI required: %w", err)
+	if opts.json && !opts.dryRun {
+		return errors.New("--json requires --dry-run")
 	}
-
 	proj, err := loadProjectFromWD()
 	if err != nil {
 		return err
 	}
-	compareCtx := defaultBranchComparisonContext(proj)
+	ghOff := ghDisabled(proj)
+	ciRepo, ciRepoErr := resolveForgeRepo(proj)
+	if !ghOff && ciRepo.usesGh() {
+		if _, err := exec.LookPath("gh"); err != nil {
+			return fmt.Errorf("gh CLI required: %w", err)
+		}
+	}
+	compareCtx := mergeTargetComparisonContext(proj, false)
 	workflow := workflowExpectationsForProject(compareCtx)
-	ciRepo, ciRepoErr := resolveGitHubRepo(proj)
 
 	if compareCtx.SyncMode == gitutil.DefaultBranchRemoteFirst {
 		if err := gitutil.FetchRemoteDefaultBranch(cmd.Context(), proj.DefaultWorktreePath, "origin", compareCtx.DefaultBranch); err != nil {
@@ -73,7 +88,7 @@ func runRm(cmd *cobra.Command, opts *rmOptions, args []string) error {
 	}
 
 	now := currentTimeOverride()
-	candidates, err := collectTidyCandidates(cmd.Context(), proj, compareCtx.CompareRef, now)
+	candidates, err := collectTidyCandidates(cmd.Context(), proj, compareCtx, now)
 	if err != nil {
 		return err
 	}
@@ -111,10 +126,17 @@ func runRm(cmd *cobra.Command, opts *rmOptions, args []string) error {
 	if err := attachProcessesToCandidates(targetCands); err != nil {
 		return err
 	}
-	for _, cand := range targetCands {
-		if err := loadRmPullRequests(cmd.Context(), cand); err != nil {
-			fmt.Fprintf(cmd.ErrOrStderr(), "warning: %s\n", singleLineError(err))
+	ghTimeout := proj.Config.GH.TimeoutDuration()
+	if ghOff {
+		markPullRequestsSkipped(targetCands)
+	} else {
+		prCtx, cancelPR := ghPhaseContext(cmd.Context(), ghTimeout)
+		for _, cand := range targetCands {
+			if err := loadRmPullRequests(prCtx, ciRepo, cand, proj.Config.GH.PRLimit); err != nil {
+				fmt.Fprintf(cmd.ErrOrStderr(), "warning: %s\n", singleLineError(err))
+			}
 		}
+		cancelPR()
 	}
 
 	statuses := make([]*worktreeStatus, len(targetCands))
@@ -127,21 +149,29 @@ func runRm(cmd *cobra.Command, opts *rmOptions, args []string) error {
 		RepoErr:    ciRepoErr,
 		RemoteName: proj.Config.CIRemote(),
 		Workdir:    proj.DefaultWorktreePath,
+		Command:    proj.Config.CI.Command,
 	}
-	if err := fetchCIStatuses(cmd.Context(), ciOpts, statuses, now, nil); err != nil && errors.Is(err, context.Canceled) {
-		fmt.Fprintf(cmd.ErrOrStderr(), "warning: %s\n", singleLineError(err))
+	if !ghOff || ciOpts.Command != "" {
+		ciCtx, cancelCI := ghPhaseContext(cmd.Context(), ghTimeout)
+		if err := fetchCIStatuses(ciCtx, ciOpts, statuses, now, nil); err != nil && (errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)) {
+			fmt.Fprintf(cmd.ErrOrStderr(), "warning: %s\n", singleLineError(err))
+		}
+		cancelCI()
 	}
 	updateCandidatesCIState(targetCands, workflow)
 
 	for _, cand := range targetCands {
-		deriveClassification(cand, tidyDeriveContext{Now: now, Workflow: workflow})
+		deriveClassification(cand, tidyDeriveContext{Now: now, Workflow: workflow, NoPRIsSafe: proj.Config.Tidy.NoPRIsSafe})
 		if cand.Classification == tidyBlocked {
 			return fmt.Errorf("cannot remove %s: %s", cand.Worktree.Name, strings.Join(cand.BlockReasons, "; "))
 		}
 	}
 
 	if opts.dryRun {
-		return renderRmDryRun(cmd.OutOrStdout(), targetCands)
+		if opts.json {
+			return writeRmDryRunJSON(cmd.OutOrStdout(), targetCands, opts.keepBranch)
+		}
+		return renderRmDryRun(cmd.OutOrStdout(), targetCands, opts.keepBranch, opts.yes || opts.force)
 	}
 
 	reader := bufio.NewReader(cmd.InOrStdin())
@@ -165,7 +195,7 @@ func runRm(cmd *cobra.Command, opts *rmOptions, args []string) error {
 			}
 		}
 
-		if cand.Classification == tidyGray && !opts.force {
+		if cand.Classification == tidyGray && !opts.force && !opts.yes {
 			proceed, quit, _, err := promptForCandidate(cmd.OutOrStdout(), reader, cand, now, useColor)
 			if err != nil {
 				return err
@@ -189,7 +219,7 @@ func runRm(cmd *cobra.Command, opts *rmOptions, args []string) error {
 			}
 		}
 
-		touched, err := performRmCleanup(cmd.Context(), cmd.ErrOrStderr(), logWriter, proj, cand, opts.force)
+		touched, err := performRmCleanup(cmd.Context(), cmd.ErrOrStderr(), logWriter, proj, cand, opts.force, opts.keepBranch)
 		if err != nil {
 			return err
 		}
@@ -209,7 +239,7 @@ func runRm(cmd *cobra.Command, opts *rmOptions, args []string) error {
 	return nil
 }
 
-func performRmCleanup(ctx context.Context, warn io.Writer, log io.Writer, proj *project.Project, cand *tidyCandidate, force bool) (bool, error) {
+func performRmCleanup(ctx context.Context, warn io.Writer, log io.Writer, proj *project.Project, cand *tidyCandidate, force, keepBranch bool) (bool, error) {
 	if cand == nil {
 		return false, nil
 	}
@@ -234,9 +264,15 @@ func performRmCleanup(ctx context.Context, warn io.Writer, log io.Writer, proj *
 	}
 
 	branch := cand.Branch
-	if branch == "" || branch == "HEAD" || branch == proj.Config.DefaultBranch {
-		if force && branch == proj.Config.DefaultBranch {
-			fmt.Fprintf(warn, "warning: skipped deleting local branch %s (default branch)\n", branch)
+	if keepBranch {
+		if log != nil && branch != "" && branch != "HEAD" {
+			fmt.Fprintf(log, "  kept branch %s\n", branch)
+		}
+		return false, nil
+	}
+	if kind := protectedBranchKind(proj, branch); branch == "" || branch == "HEAD" || kind != "" {
+		if force && kind != "" {
+			fmt.Fprintf(warn, "warning: skipped deleting local branch %s (%s)\n", branch, kind)
 		}
 		return false, nil
 	}
@@ -250,12 +286,13 @@ func performRmCleanup(ctx context.Context, warn io.Writer, log io.Writer, proj *
 		fmt.Fprintf(warn, "warning: failed to delete local branch %s: %s\n", branch, singleLineError(err))
 	}
 
-	if cand.HasRemoteBranch && cand.RemoteMatchesHead {
-		if err := gitDeleteRemoteBranch(proj.DefaultWorktreePath, branch, log); err != nil {
+	if cand.deletesRemoteBranch() {
+		remoteBranch := cand.remoteBranchName()
+		if err := gitDeleteRemoteBranch(proj.DefaultWorktreePath, remoteBranch, log); err != nil {
 			if !force {
 				return remoteTouched, err
 			}
-			fmt.Fprintf(warn, "warning: failed to delete remote branch origin/%s: %s\n", branch, singleLineError(err))
+			fmt.Fprintf(warn, "warning: failed to delete remote branch origin/%s: %s\n", remoteBranch, singleLineError(err))
 		} else {
 			remoteTouched = true
 		}
@@ -312,10 +349,13 @@ func resolveRmTargets(worktrees []project.Worktree, proj *project.Project, args
 		if wt.Name == proj.DefaultWorktree {
 			return nil, fmt.Errorf("cannot remove the default worktree (%s)", wt.Name)
 		}
+		if wt.Main {
+			return nil, fmt.Errorf("cannot remove %s: %s", wt.Name, blockReasonMainWorkingTree)
+		}
 		return []project.Worktree{*wt}, nil
 	}
 
-	targets, err := resolveWorktreeArgs(worktrees, args, wd)
+	targets, err := resolveWorktreeArgs(worktrees, args, targetBaseDir(proj, wd))
 	if err != nil {
 		return nil, err
 	}
@@ -324,16 +364,19 @@ func resolveRmTargets(worktrees []project.Worktree, proj *project.Project, args
 		if target.Name == proj.DefaultWorktree {
 			return nil, fmt.Errorf("cannot remove the default worktree (%s)", target.Name)
 		}
+		if target.Main {
+			return nil, fmt.Errorf("cannot remove %s: %s", target.Name, blockReasonMainWorkingTree)
+		}
 		result = append(result, target)
 	}
 	return result, nil
 }
 
-func loadRmPullRequests(ctx context.Context, cand *tidyCandidate) error {
+func loadRmPullRequests(ctx context.Context, repo *forgeRepo, cand *tidyCandidate, prLimit int) error {
 	if len(cand.BlockReasons) > 0 {
 		return nil
 	}
-	prs, err := queryPullRequests(ctx, cand.Worktree.Path, cand.Branch)
+	prs, err := queryPullRequests(ctx, repo, cand.Worktree.Path, cand.Branch, prLimit)
 	if err != nil {
 		cand.extraGrayReasons = append(cand.extraGrayReasons, fmt.Sprintf("PR lookup failed: %s", singleLineError(err)))
 		return fmt.Errorf("%s: %w", cand.Worktree.Name, err)
@@ -349,22 +392,26 @@ func loadRmPullRequests(ctx context.Context, cand *tidyCandidate) error {
 	return nil
 }
 
-func renderRmDryRun(out io.Writer, cands []*tidyCandidate) error {
+func renderRmDryRun(out io.Writer, cands []*tidyCandidate, keepBranch, autoConfirm bool) error {
 	var needsRemote bool
 	for i, cand := range cands {
 		fmt.Fprintf(out, "Will clean up %s (branch %s)\n", cand.Worktree.Name, cand.Branch)
-		for _, action := range plannedActions(cand) {
+		for _, action := range rmPlannedActions(cand, keepBranch) {
 			fmt.Fprintf(out, "  - %s\n", action)
 		}
 		fmt.Fprintln(out)
 		if cand.Classification == tidyGray {
-			fmt.Fprintln(out, "Worktree requires confirmation:")
+			if autoConfirm {
+				fmt.Fprintln(out, "Worktree auto-confirmed:")
+			} else {
+				fmt.Fprintln(out, "Worktree requires confirmation:")
+			}
 			for _, reason := range cand.GrayReasons {
 				fmt.Fprintf(out, "  - %s\n", reason)
 			}
 			fmt.Fprintln(out)
 		}
-		if cand.HasRemoteBranch && cand.RemoteMatchesHead {
+		if rmNeedsRemotePrune(cand, keepBranch) {
 			needsRemote = true
 		}
 		if i < len(cands)-1 {
@@ -378,6 +425,72 @@ func renderRmDryRun(out io.Writer, cands []*tidyCandidate) error {
 	return nil
 }
 
+// rmPlannedActions lists what wt rm will do to cand, in order.
+func rmPlannedActions(cand *tidyCandidate, keepBranch bool) []string {
+	if keepBranch {
+		return []string{
+			fmt.Sprintf("remove worktree %s", cand.Worktree.Path),
+			fmt.Sprintf("keep branch %s", cand.Branch),
+		}
+	}
+	return plannedActions(cand)
+}
+
+// rmNeedsRemotePrune reports whether removing cand deletes a remote branch,
+// after which origin's remote-tracking refs want pruning.
+func rmNeedsRemotePrune(cand *tidyCandidate, keepBranch bool) bool {
+	return !keepBranch && cand.deletesRemoteBranch()
+}
+
+// rmDryRunJSONVersion identifies the `wt rm --dry-run --json` schema, bumped
+// under the same rules as statusJSONVersion.
+const rmDryRunJSONVersion = 1
+
+type rmDryRunJSONDocument struct {
+	Version     int                    `json:"version"`
+	Worktrees   []rmDryRunJSONWorktree `json:"worktrees"`
+	RemotePrune bool                   `json:"remote_prune"`
+}
+
+type rmDryRunJSONWorktree struct {
+	Name string `json:"name"`
+	Path string `json:"path"`
+	// Branch is empty for a detached HEAD.
+	Branch string `json:"branch"`
+	// Classification is "safe" or "gray"; blocked targets fail the command
+	// before anything is printed.
+	Classification string   `json:"classification"`
+	Actions        []string `json:"actions"`
+	GrayReasons    []string `json:"gray_reasons"`
+	RemotePrune    bool     `json:"remote_prune"`
+}
+
+// writeRmDryRunJSON prints the same plan as renderRmDryRun as one JSON
+// document, so wrapper scripts can check it before running wt rm for real.
+func writeRmDryRunJSON(w io.Writer, cands []*tidyCandidate, keepBranch bool) error {
+	doc := rmDryRunJSONDocument{Version: rmDryRunJSONVersion, Worktrees: []rmDryRunJSONWorktree{}}
+	for _, cand := range cands {
+		entry := rmDryRunJSONWorktree{
+			Name:           cand.Worktree.Name,
+			Path:           cand.Worktree.Path,
+			Branch:         cand.Branch,
+			Classification: "safe",
+			Actions:        rmPlannedActions(cand, keepBranch),
+			GrayReasons:    []string{},
+			RemotePrune:    rmNeedsRemotePrune(cand, keepBranch),
+		}
+		if cand.Classification == tidyGray {
+			entry.Classification = "gray"
+			entry.GrayReasons = append(entry.GrayReasons, cand.GrayReasons...)
+		}
+		doc.RemotePrune = doc.RemotePrune || entry.RemotePrune
+		doc.Worktrees = append(doc.Worktrees, entry)
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(doc)
+}
+
 func removeBlockReason(cand *tidyCandidate, target string) bool {
 	if len(cand.BlockReasons) == 0 {
 		return false
@@ -13,17 +13,26 @@ import (
 )
 
 func Execute() error {
-	return newRootCommand().Execute()
+	opts := &rootOptions{}
+	cmd := newRootCommand(opts)
+	args, err := expandAliases(cmd, os.Args[1:], os.Stderr)
+	if err != nil {
+		return err
+	}
+	opts.args = args
+	cmd.SetArgs(args)
+	return cmd.Execute()
 }
 
 type rootOptions struct {
+	// args are the command-line arguments after alias expansion.
+	args      []string
 	tracePath string
 	traceFile *os.File
 	traceTask *trace.Task
 }
 
-func newRootCommand() *cobra.Command {
-	opts := &rootOptions{}
+func newRootCommand(opts *rootOptions) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:           "wt",
 		Short:         "Brandon Bloom's experimental, opinionated, personal worktree manager.",
@@ -31,16 +40,21 @@ func newRootCommand() *cobra.Command {
 		SilenceErrors: true,
 		Version:       version.String(),
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			canonicalPathCache = newPathCache()
 			return applyPreRunFlags(cmd, opts)
 		},
 		PersistentPostRunE: func(cmd *cobra.Command, args []string) error {
+			canonicalPathCache = nil
 			stopRuntimeTrace(opts)
 			return nil
 		},
-		RunE: runStatus,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runStatus(cmd, &statusOptions{}, args)
+		},
 	}
 
 	cmd.PersistentFlags().StringArrayP("directory", "C", nil, "change to directory before doing anything")
+	cmd.PersistentFlags().String("project", "", "operate on the wt project containing this directory without changing the working directory")
 	cmd.PersistentFlags().StringVar(&opts.tracePath, "trace", "", "write a Go execution trace to file (relative to current dir after any earlier -C; view with `go tool trace` or Perfetto)")
 
 	cmd.AddCommand(
@@ -48,13 +62,24 @@ func newRootCommand() *cobra.Command {
 		newInitCommand(),
 		newCloneCommand(),
 		newNewCommand(),
+		newBranchCommand(),
+		newReopenCommand(),
+		newSyncCommand(),
+		newPRCommand(),
 		newBootstrapCommand(),
 		newStatusCommand(),
 		newActivateCommand(),
 		newDoctorCommand(),
+		newConfigCommand(),
+		newWhereamiCommand(),
 		newTidyCommand(),
 		newRmCommand(),
+		newGCCommand(),
+		newMoveCommand(),
+		newLockCommand(),
+		newUnlockCommand(),
 		newKillCommand(),
+		newSweepCommand(),
 	)
 
 	return cmd
@@ -108,10 +133,11 @@ func applyPreRunFlags(cmd *cobra.Command, opts *rootOptions) error {
 	flagSet.ParseErrorsAllowlist.UnknownFlags = true
 	flagSet.SetInterspersed(true)
 	flagSet.StringArrayP("directory", "C", nil, "")
+	flagSet.String("project", "", "")
 	flagSet.String("trace", "", "")
 
 	traceStarted := false
-	err := flagSet.ParseAll(os.Args[1:], func(flag *pflag.Flag, value string) error {
+	err := flagSet.ParseAll(opts.args, func(flag *pflag.Flag, value string) error {
 		switch flag.Name {
 		case "directory":
 			if value == "" {
@@ -121,6 +147,21 @@ func applyPreRunFlags(cmd *cobra.Command, opts *rootOptions) error {
 				return fmt.Errorf("chdir to %q: %w", value, err)
 			}
 			return nil
+		case "project":
+			if value == "" {
+				return fmt.Errorf("project: empty directory")
+			}
+			dir, err := filepath.Abs(value)
+			if err != nil {
+				return err
+			}
+			if info, err := os.Stat(dir); err != nil {
+				return fmt.Errorf("project: %w", err)
+			} else if !info.IsDir() {
+				return fmt.Errorf("project: %s is not a directory", value)
+			}
+			projectDirOverride = dir
+			return nil
 		case "trace":
 			if traceStarted {
 				return fmt.Errorf("trace: multiple --trace flags are not supported")
@@ -151,9 +192,38 @@ func applyPreRunFlags(cmd *cobra.Command, opts *rootOptions) error {
 }
 
 func newStatusCommand() *cobra.Command {
-	return &cobra.Command{
-		Use:   "status",
+	opts := &statusOptions{}
+	cmd := &cobra.Command{
+		Use:   "status [<worktrees...>]",
 		Short: "Show the wt status dashboard",
-		RunE:  runStatus,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runStatus(cmd, opts, args)
+		},
 	}
+	cmd.Flags().BoolVar(&opts.ciOnlyFailures, "ci-only-failures", false, "list only worktrees with failing CI and exit non-zero if any")
+	cmd.Flags().StringVar(&opts.showPath, "show-path", "", "append each worktree's path to the name column (relative or absolute)")
+	cmd.Flags().Lookup("show-path").NoOptDefVal = showPathRelative
+	cmd.Flags().BoolVar(&opts.againstDefault, "against-default", false, "measure divergence against default_branch even when integration_branch is set")
+	cmd.Flags().StringVar(&opts.timeFormat, "time", timeFormatRelative, "time column format: relative or absolute (YYYY-MM-DD HH:MM)")
+	cmd.Flags().BoolVar(&opts.errorsOnly, "errors-only", false, "show only worktrees with errors or failing CI (\"all clear\" otherwise); exit non-zero if any")
+	cmd.Flags().BoolVar(&opts.fetch, "fetch", false, "run git fetch origin first so ahead/behind reflect the remote")
+	cmd.Flags().BoolVar(&opts.explain, "explain", false, "print a legend of the status glyphs (↑↓, [+n -m], CI✓, ...) and exit")
+	cmd.Flags().BoolVar(&opts.showSubject, "show-subject", false, "add a column with each worktree's HEAD commit subject")
+	cmd.Flags().BoolVar(&opts.remoteOnly, "remote-only", false, "show only upstream ahead/behind (↑↓), hiding default-branch divergence")
+	cmd.Flags().BoolVar(&opts.json, "json", false, "emit machine-readable JSON ({\"version\": 1, \"worktrees\": [...]})")
+	cmd.Flags().BoolVar(&opts.stream, "stream", false, "with --json, print one JSON object per worktree per line as each finishes loading")
+	cmd.Flags().BoolVar(&opts.check, "check", false, "exit non-zero when any worktree has a rebase/merge/etc. in progress")
+	cmd.Flags().StringVar(&opts.ageFlag, "age", "", "highlight worktrees idle longer than this (e.g. 7d); red past twice the threshold")
+	cmd.Flags().StringVar(&opts.template, "template", "", "format each worktree with a Go text/template (e.g. '{{.Name}} {{.Branch}}')")
+	cmd.Flags().BoolVar(&opts.submodules, "submodules", false, "count submodule modifications as dirty even if git is configured to ignore them")
+	cmd.Flags().StringVar(&opts.intervalCache, "interval-cache", "", "share PR/CI results with other wt status runs for this long (e.g. 30s)")
+	cmd.Flags().StringVar(&opts.remote, "remote", "", "look up PRs and CI on this git remote's GitHub repo instead of [ci].remote (e.g. upstream)")
+	cmd.Flags().StringVar(&opts.baseRef, "base", "", "compute divergence ([+n -m]) against this ref instead of origin/<default> (e.g. origin/develop)")
+	cmd.Flags().IntVar(&opts.staleDays, "stale-days", 0, "mark worktrees with unique commits idle longer than this many days as would-tidy")
+	cmd.Flags().IntVar(&opts.divergence, "divergence", 0, "mark worktrees with unique commits diverged more than this many commits as would-tidy")
+	cmd.Flags().IntVar(&opts.limit, "limit", 0, "show only the N most recently active worktrees, with a count of the rest")
+	cmd.Flags().BoolVar(&opts.all, "all", false, "show every worktree, overriding --limit (the default)")
+	cmd.Flags().BoolVar(&opts.noProcesses, "no-processes", false, "skip process detection and omit the process summary")
+	cmd.Flags().BoolVar(&opts.baseOnly, "base-only", false, "show only default-branch divergence ([+n -m]), hiding upstream ahead/behind")
+	return cmd
 }
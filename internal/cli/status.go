@@ -14,6 +14,8 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"text/template"
 	"time"
 
 	"github.com/brandonbloom/wt/internal/gitutil"
@@ -27,20 +29,150 @@ import (
 	"golang.org/x/term"
 )
 
-func runStatus(cmd *cobra.Command, args []string) error {
+type statusOptions struct {
+	ciOnlyFailures bool
+	showPath       string
+	remoteOnly     bool
+	baseOnly       bool
+	ageFlag        string
+	check          bool
+	json           bool
+	template       string
+	submodules     bool
+	intervalCache  string
+	showSubject    bool
+	baseRef        string
+	staleDays      int
+	divergence     int
+	timeFormat     string
+	againstDefault bool
+	explain        bool
+	fetch          bool
+	errorsOnly     bool
+	stream         bool
+	remote         string
+	limit          int
+	all            bool
+	noProcesses    bool
+}
+
+const (
+	showPathRelative = "relative"
+	showPathAbsolute = "absolute"
+
+	timeFormatRelative = "relative"
+	timeFormatAbsolute = "absolute"
+)
+
+func runStatus(cmd *cobra.Command, opts *statusOptions, args []string) error {
+	if opts.explain {
+		writeStatusLegend(cmd.OutOrStdout())
+		return nil
+	}
 	statusPreflight(cmd)
 	ctx := cmd.Context()
+	if opts.json && opts.ciOnlyFailures {
+		return errors.New("cannot combine --json and --ci-only-failures")
+	}
+	if opts.remoteOnly && opts.baseOnly {
+		return errors.New("cannot combine --remote-only and --base-only")
+	}
+	if opts.staleDays < 0 || opts.divergence < 0 {
+		return errors.New("--stale-days and --divergence must not be negative")
+	}
+	var ageThreshold time.Duration
+	if strings.TrimSpace(opts.ageFlag) != "" {
+		d, err := parseDurationWithDays(opts.ageFlag)
+		if err != nil {
+			return fmt.Errorf("invalid --age value %q (examples: 7d, 48h)", opts.ageFlag)
+		}
+		ageThreshold = d
+	}
+	var cacheMaxAge time.Duration
+	if strings.TrimSpace(opts.intervalCache) != "" {
+		d, err := parseDurationWithDays(opts.intervalCache)
+		if err != nil || d <= 0 {
+			return fmt.Errorf("invalid --interval-cache value %q (examples: 30s, 2m)", opts.intervalCache)
+		}
+		cacheMaxAge = d
+	}
+	if opts.template != "" && (opts.json || opts.ciOnlyFailures) {
+		return errors.New("--template cannot be combined with --json or --ci-only-failures")
+	}
+	if opts.errorsOnly && (opts.json || opts.ciOnlyFailures || opts.template != "") {
+		return errors.New("--errors-only cannot be combined with --json, --template, or --ci-only-failures")
+	}
+	if opts.stream && !opts.json {
+		return errors.New("--stream requires --json")
+	}
+	if opts.limit < 0 {
+		return errors.New("--limit must not be negative")
+	}
+	limit := opts.limit
+	if opts.all {
+		limit = 0
+	}
+	if limit > 0 && (opts.errorsOnly || opts.ciOnlyFailures) {
+		return errors.New("--limit cannot be combined with --errors-only or --ci-only-failures")
+	}
+	switch opts.showPath {
+	case "", showPathRelative, showPathAbsolute:
+	default:
+		return fmt.Errorf("invalid --show-path value %q (expected relative or absolute)", opts.showPath)
+	}
+	switch opts.timeFormat {
+	case "", timeFormatRelative, timeFormatAbsolute:
+	default:
+		return fmt.Errorf("invalid --time value %q (expected relative or absolute)", opts.timeFormat)
+	}
 	proj, err := withTraceRegion(ctx, "discover project", loadProjectFromWD)
 	if err != nil {
 		return err
 	}
+	ciRemote := proj.Config.CIRemote()
+	if remote := strings.TrimSpace(opts.remote); remote != "" {
+		if _, err := gitutil.RemoteURL(projectGitDir(proj), remote); err != nil {
+			return fmt.Errorf("--remote %s: no such git remote", remote)
+		}
+		ciRemote = remote
+	}
+	ciRepo, ciRepoErr := func() (*forgeRepo, error) {
+		region := trace.StartRegion(ctx, "resolve forge repo")
+		defer region.End()
+		return resolveForgeRepoFromRemote(proj, ciRemote)
+	}()
+	ghAuth := startGhAuthCheck(ctx, proj, ciRepo)
+	ghOff := ghDisabled(proj)
+	prPending := prLoadingLabel
+	if ghOff {
+		prPending = ""
+	}
+	opts.baseRef = strings.TrimSpace(opts.baseRef)
+	if opts.baseRef != "" {
+		exists, err := gitutil.CommitExists(proj.DefaultWorktreePath, opts.baseRef)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("--base %s does not name a commit", opts.baseRef)
+		}
+	}
 
 	worktrees, err := withTraceRegion(ctx, "list worktrees", func() ([]project.Worktree, error) {
-		return project.ListWorktrees(proj.Root)
+		listed, err := project.ListWorktrees(proj.Root)
+		if err != nil {
+			return nil, err
+		}
+		return append(listed, project.MissingWorktrees(proj.Root, listed)...), nil
 	})
 	if err != nil {
 		return err
 	}
+	if len(args) > 0 {
+		if worktrees, err = selectWorktreesByName(worktrees, args); err != nil {
+			return err
+		}
+	}
 
 	wd, err := os.Getwd()
 	if err != nil {
@@ -56,8 +188,17 @@ func runStatus(cmd *cobra.Command, args []string) error {
 	}
 
 	now := currentTimeOverride()
+	var tmpl *template.Template
+	if opts.template != "" {
+		if tmpl, err = parseStatusTemplate(opts.template, now); err != nil {
+			return err
+		}
+	}
 	out := cmd.OutOrStdout()
 	termWidth, isTTY := terminalWidth(out)
+	if opts.ciOnlyFailures || opts.errorsOnly || opts.json || tmpl != nil {
+		isTTY = false
+	}
 
 	// Render a placeholder table immediately on TTYs; fill in the expensive git +
 	// process details after the first print.
@@ -68,12 +209,19 @@ func runStatus(cmd *cobra.Command, args []string) error {
 			Path:     wt.Path,
 			Branch:   wt.Name,
 			Current:  wt.Name == current,
-			PRStatus: prLoadingLabel,
+			PRStatus: prPending,
 		})
 	}
+	applyStatusDisplay(statuses, proj.Root, opts)
 
-	layout := buildColumnLayout(statuses, now, termWidth)
-	layout.useColor = isTTY
+	var layout columnLayout
+	relayout := func() {
+		layout = buildColumnLayout(limitStatuses(statuses, limit), now, termWidth, opts.showSubject)
+		layout.useColor = isTTY
+		layout.hyperlinks = isTTY && terminalHyperlinksSupported()
+		layout.ageThreshold = ageThreshold
+	}
+	relayout()
 	if os.Getenv("WT_DEBUG_STATUS") != "" {
 		fmt.Fprintf(cmd.ErrOrStderr(), "status debug: tty=%t rows=%d\n", isTTY, len(statuses))
 	}
@@ -83,30 +231,49 @@ func runStatus(cmd *cobra.Command, args []string) error {
 	var renderer *statusRenderer
 	if isTTY {
 		renderer = newStatusRenderer(out)
-		if renderer != nil {
-			renderer.Render(statuses, layout, now)
+	}
+	// repaint re-measures the terminal after a resize so the rows reflow to
+	// the new width instead of wrapping over each other.
+	repaint := func() {
+		if renderer == nil {
+			return
+		}
+		if renderer.takeResize() {
+			termWidth, _ = terminalWidth(out)
+			relayout()
 		}
+		renderer.Render(limitStatuses(statuses, limit), layout, now)
 	}
 	var rerender func(*worktreeStatus)
 	if renderer != nil {
+		stopResize := watchTerminalResize(renderer.markResized)
+		defer stopResize()
+		repaint()
 		rerender = func(*worktreeStatus) {
-			renderer.Render(statuses, layout, now)
+			repaint()
+		}
+	}
+
+	phases := newPhaseTimer()
+	defer phases.report(cmd.ErrOrStderr())
+
+	if opts.fetch && proj.DefaultWorktreePath != "" {
+		err := phases.run(ctx, "fetch origin", func() error {
+			return gitutil.FetchRemote(interruptCtx, proj.DefaultWorktreePath, "origin")
+		})
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "warning: %s\n", singleLineError(err))
 		}
 	}
 
 	compareCtx := func() defaultBranchCompareContext {
 		region := trace.StartRegion(ctx, "resolve default compare ref")
 		defer region.End()
-		return defaultBranchComparisonContext(proj)
+		return mergeTargetComparisonContext(proj, opts.againstDefault)
 	}()
 	workflow := workflowExpectationsForProject(compareCtx)
-	ciRepo, ciRepoErr := func() (*githubRepo, error) {
-		region := trace.StartRegion(ctx, "resolve github repo")
-		defer region.End()
-		return resolveGitHubRepo(proj)
-	}()
 
-	err = withTraceRegionErr(ctx, "collect git status", func() error {
+	err = phases.run(ctx, "collect git status", func() error {
 		stashBranches, stashErr := func() (map[string]bool, error) {
 			stashRegion := trace.StartRegion(ctx, "git stash index")
 			defer stashRegion.End()
@@ -122,6 +289,17 @@ func runStatus(cmd *cobra.Command, args []string) error {
 		if stashErr != nil {
 			return stashErr
 		}
+		gatherOpts := gatherWorktreeGitDataOptionsStatus
+		gatherOpts.StashBranches = stashBranches
+		gatherOpts.IncludeSubmodules = opts.submodules
+		gatherOpts.IncludeSubject = opts.showSubject
+		gatherOpts.BaseRef = opts.baseRef
+		gatherOpts.TargetBranch = compareCtx.DefaultBranch
+		gatherOpts.AllowPartial = true
+		// Without origin/<default> the remote was never fetched (or does not
+		// exist), so every branch would look unpushed.
+		gatherOpts.IncludePushState = proj.DefaultWorktreePath != "" &&
+			gitutil.RefExists(proj.DefaultWorktreePath, "refs/remotes/origin/"+proj.Config.DefaultBranch)
 
 		parallelism := runtime.GOMAXPROCS(0)
 		if parallelism < 1 {
@@ -132,6 +310,9 @@ func runStatus(cmd *cobra.Command, args []string) error {
 		}
 		sem := make(chan struct{}, parallelism)
 
+		// The default worktree is never bootstrapped by wt, so only the others
+		// are checked for the marker.
+		bootstrapConfigured := strings.TrimSpace(proj.Config.Bootstrap.Run) != ""
 		collected := make([]*worktreeStatus, len(worktrees))
 		var wg sync.WaitGroup
 		for i, wt := range worktrees {
@@ -141,10 +322,14 @@ func runStatus(cmd *cobra.Command, args []string) error {
 				sem <- struct{}{}
 				defer func() { <-sem }()
 
+				if wt.Missing || !pathExists(wt.Path) {
+					collected[i] = missingWorktreeStatus(wt, wt.Name == current)
+					return
+				}
 				status, werr := func() (*worktreeStatus, error) {
 					wtRegion := trace.StartRegion(ctx, "worktree "+wt.Name)
 					defer wtRegion.End()
-					return collectWorktreeStatus(ctx, proj, wt, compareCtx.CompareRef, stashBranches)
+					return collectWorktreeStatus(ctx, proj, wt, compareCtx.CompareRef, gatherOpts)
 				}()
 				if werr != nil {
 					msg := singleLineError(werr)
@@ -164,7 +349,11 @@ func runStatus(cmd *cobra.Command, args []string) error {
 					return
 				}
 				status.Current = wt.Name == current
-				status.PRStatus = prLoadingLabel
+				status.NeedsBootstrap = bootstrapConfigured && wt.Name != proj.DefaultWorktree && !hasBootstrapMarker(wt.Path)
+				status.PRStatus = prPending
+				if status.Unborn {
+					status.PRStatus = unbornLabel
+				}
 				collected[i] = status
 			}(i, wt)
 		}
@@ -181,11 +370,18 @@ func runStatus(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	err = withTraceRegionErr(ctx, "collect processes", func() error {
-		return attachProcessesToStatuses(statuses, worktrees)
-	})
-	if err != nil {
-		return err
+	markSharedBranches(statuses)
+	markDefaultBranchCheckouts(statuses, proj.DefaultWorktree, proj.Config.DefaultBranch)
+	markTidyThresholds(statuses, tidyThresholds{DivergenceCommits: opts.divergence, StaleDays: opts.staleDays}, now, compareCtx.DefaultBranch)
+	applyStatusDisplay(statuses, proj.Root, opts)
+
+	if !opts.noProcesses && proj.Config.Status.ShowProcessesEnabled() {
+		err = phases.run(ctx, "collect processes", func() error {
+			return attachProcessesToStatuses(statuses, worktrees)
+		})
+		if err != nil {
+			return err
+		}
 	}
 
 	sort.SliceStable(statuses, func(i, j int) bool {
@@ -194,18 +390,73 @@ func runStatus(cmd *cobra.Command, args []string) error {
 		}
 		return statuses[i].Timestamp.After(statuses[j].Timestamp)
 	})
+	// Truncate before the GitHub phases so hidden rows cost no gh calls.
+	hidden := len(statuses) - len(limitStatuses(statuses, limit))
+	statuses = limitStatuses(statuses, limit)
+
+	relayout()
+	repaint()
+
+	ghTimeout := proj.Config.GH.TimeoutDuration()
+	fetchTargets := remoteStatusTargets(statuses)
+	var cache *statusCache
+	unlockCache := func() {}
+	cachePath, cacheLockPath := statusCachePaths(proj.Root)
+	if cacheMaxAge > 0 && !ghOff {
+		unlock, lerr := lockStatusCache(interruptCtx, cacheLockPath, 2*ghTimeout)
+		if lerr != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "warning: status cache unavailable: %s\n", singleLineError(lerr))
+		} else {
+			unlockCache = unlock
+			defer func() { unlockCache() }()
+			cache, err = loadStatusCache(cachePath)
+			if err != nil {
+				fmt.Fprintf(cmd.ErrOrStderr(), "warning: status cache unreadable: %s\n", singleLineError(err))
+			}
+			cached := len(fetchTargets)
+			fetchTargets = cache.apply(fetchTargets, time.Now(), cacheMaxAge)
+			if len(fetchTargets) < cached {
+				repaint()
+			}
+		}
+	}
 
-	layout = buildColumnLayout(statuses, now, termWidth)
-	layout.useColor = isTTY
-	if renderer != nil {
-		renderer.Render(statuses, layout, now)
+	var stream *statusJSONStream
+	ciUpdate := rerender
+	if opts.json && opts.stream {
+		stream = newStatusJSONStream(out)
+		stream.emitSettled(statuses, fetchTargets)
+		// CI is the last phase, so a row is complete once its CI result lands.
+		ciUpdate = stream.emit
 	}
 
-	err = withTraceRegionErr(ctx, "fetch pull requests", func() error {
-		return fetchPullRequestStatuses(interruptCtx, ciRepo, ciRepoErr, statuses, workflow, rerender)
-	})
-	if err != nil && errors.Is(err, context.Canceled) {
-		fmt.Fprintln(cmd.ErrOrStderr(), "warning: cancelled GitHub fetch")
+	// Without gh credentials every lookup would fail with its own cryptic
+	// error, so skip them and say why once.
+	var ghErr error
+	if len(fetchTargets) > 0 || ghOff {
+		ghErr = ghAuth()
+	}
+	if ghErr != nil {
+		skipped := "PR and CI lookups"
+		if proj.Config.CI.Command != "" {
+			skipped = "PR lookups"
+		}
+		if !errors.Is(ghErr, errGhDisabled) {
+			fmt.Fprintf(cmd.ErrOrStderr(), "warning: %s (skipping %s)\n", ghErr, skipped)
+		}
+		for _, status := range fetchTargets {
+			if status.PRStatus == prLoadingLabel {
+				status.PRStatus = ""
+			}
+		}
+		repaint()
+	} else {
+		err = phases.run(ctx, "fetch pull requests", func() error {
+			prCtx, cancel := ghPhaseContext(interruptCtx, ghTimeout)
+			defer cancel()
+			return fetchPullRequestStatuses(prCtx, ciRepo, ciRepoErr, fetchTargets, workflow, proj.Config.GH.PRLimit, rerender)
+		})
+		warnGitHubFetchAborted(cmd, err, ghTimeout)
 	}
 
 	if renderer != nil {
@@ -219,22 +470,92 @@ func runStatus(cmd *cobra.Command, args []string) error {
 	ciOpts := ciFetchOptions{
 		Repo:       ciRepo,
 		RepoErr:    ciRepoErr,
-		RemoteName: proj.Config.CIRemote(),
+		RemoteName: ciRemote,
 		Workdir:    proj.DefaultWorktreePath,
+		Command:    proj.Config.CI.Command,
 	}
-	err = withTraceRegionErr(ctx, "fetch ci status", func() error {
-		return fetchCIStatuses(interruptCtx, ciOpts, statuses, now, rerender)
-	})
-	if err != nil && errors.Is(err, context.Canceled) {
-		fmt.Fprintln(cmd.ErrOrStderr(), "warning: cancelled GitHub fetch")
+	if ghErr == nil || ciOpts.Command != "" {
+		err = phases.run(ctx, "fetch ci status", func() error {
+			ciCtx, cancel := ghPhaseContext(interruptCtx, ghTimeout)
+			defer cancel()
+			return fetchCIStatuses(ciCtx, ciOpts, fetchTargets, now, ciUpdate)
+		})
+		warnGitHubFetchAborted(cmd, err, ghTimeout)
+	}
+
+	// Skipped lookups must not be cached as if they had found nothing.
+	if cache != nil && ghErr == nil {
+		cache.record(fetchTargets, time.Now())
+		if err := saveStatusCache(cachePath, cache); err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "warning: status cache not saved: %s\n", singleLineError(err))
+		}
+		unlockCache()
+		unlockCache = func() {}
+	}
+
+	// --check applies to every output mode, so scripts can pair it with
+	// --json or --template.
+	var checkErr error
+	if opts.check {
+		if inProgress := countOperationsInProgress(statuses); inProgress > 0 {
+			checkErr = fmt.Errorf("%d worktree(s) have a git operation in progress", inProgress)
+		}
+	}
+
+	if stream != nil {
+		if err := stream.finish(statuses); err != nil {
+			return err
+		}
+		return checkErr
+	}
+	if opts.json {
+		if err := writeStatusJSON(out, statuses); err != nil {
+			return err
+		}
+		return checkErr
+	}
+	if tmpl != nil {
+		if err := executeStatusTemplate(out, tmpl, statuses); err != nil {
+			return err
+		}
+		return checkErr
+	}
+	if opts.ciOnlyFailures {
+		if failed := printCIFailures(out, statuses); failed > 0 {
+			return fmt.Errorf("CI failing in %d worktree(s)", failed)
+		}
+		return checkErr
+	}
+	if opts.errorsOnly {
+		problems := make([]*worktreeStatus, 0, len(statuses))
+		for _, status := range statuses {
+			if statusHasProblem(status) {
+				problems = append(problems, status)
+			}
+		}
+		if len(problems) == 0 {
+			fmt.Fprintln(out, "all clear")
+			return checkErr
+		}
+		statuses = problems
+		relayout()
+		printStatuses(out, statuses, now, layout)
+		printCIDetail(out, statuses, now)
+		return fmt.Errorf("%d worktree(s) need attention", len(statuses))
 	}
 
 	if renderer == nil {
 		printStatuses(out, statuses, now, layout)
 	}
+	if hidden > 0 {
+		fmt.Fprintf(out, "... and %d more (use --all)\n", hidden)
+	}
 	printCIDetail(out, statuses, now)
-
-	return nil
+	printOperationsInProgress(out, statuses)
+	if !opts.fetch {
+		hintStaleFetch(cmd.ErrOrStderr(), proj, now)
+	}
+	return checkErr
 }
 
 type worktreeStatus struct {
@@ -242,6 +563,7 @@ type worktreeStatus struct {
 	Path           string
 	Branch         string
 	Dirty          bool
+	SubmodulesOnly bool
 	HasStash       bool
 	Ahead          int
 	Behind         int
@@ -250,6 +572,8 @@ type worktreeStatus struct {
 	UniqueAhead    int
 	Timestamp      time.Time
 	HeadHash       string
+	Unborn         bool
+	Subject        string
 	Current        bool
 	PRStatus       string
 	Operation      string
@@ -259,38 +583,175 @@ type worktreeStatus struct {
 	Error          string
 	HasError       bool
 	HasPendingWork bool
-	PullRequests   []pullRequestInfo
-	CIStatus       string
-	CIState        ciState
-	CIDetail       []ciRunSummary
+	SharedBranch   bool
+	// SharesCurrentBranch marks every row (including the current one) whose
+	// branch is also checked out in the current worktree.
+	SharesCurrentBranch bool
+	// OnDefaultBranch marks a non-default worktree that has the default
+	// branch checked out, which makes its deltas meaningless.
+	OnDefaultBranch bool
+	// Locked mirrors `git worktree lock`.
+	Locked bool
+	// UpstreamGone marks a branch whose configured upstream was deleted, in
+	// which case Ahead/Behind are meaningless zeros.
+	UpstreamGone bool
+	// Unpushed marks a branch that was never pushed: no upstream and no
+	// origin/<branch>.
+	Unpushed bool
+	// PartialErrors names the git fields that failed to load while the rest
+	// of the row did; the failed columns render blank.
+	PartialErrors []gitFieldError
+	// NeedsBootstrap marks a non-default worktree where the configured
+	// bootstrap script has not succeeded (skipped, failed, or predates it).
+	NeedsBootstrap bool
+	// TreeMatchesDefault reports that HEAD's tree is identical to the default
+	// branch's, the signal tidy treats as safe to remove.
+	TreeMatchesDefault bool
+	// TidyReasons lists the --stale-days/--divergence thresholds this row
+	// exceeds, previewing what wt tidy would flag.
+	TidyReasons  []string
+	DisplayPath  string
+	AbsoluteTime bool
+	HideUpstream bool
+	HideBase     bool
+	PullRequests []pullRequestInfo
+	CIStatus     string
+	CIState      ciState
+	// CIDetail holds the failing run the table and CI details section show;
+	// CIRuns is every run behind CIState, for --json.
+	CIDetail []ciRunSummary
+	CIRuns   []ciRunSummary
+	// CIMergeRef marks CI read from the open PR's merge ref instead of HEAD.
+	CIMergeRef bool
 }
 
-func collectWorktreeStatus(ctx context.Context, proj *project.Project, wt project.Worktree, defaultCompareRef string, stashBranches map[string]bool) (*worktreeStatus, error) {
-	opts := gatherWorktreeGitDataOptionsStatus
-	opts.StashBranches = stashBranches
+func collectWorktreeStatus(ctx context.Context, proj *project.Project, wt project.Worktree, defaultCompareRef string, opts gatherWorktreeGitDataOptions) (*worktreeStatus, error) {
 	data, err := gatherWorktreeGitData(ctx, proj, wt, defaultCompareRef, opts)
 	if err != nil {
 		return nil, err
 	}
 	status := &worktreeStatus{
-		Name:        wt.Name,
-		Path:        wt.Path,
-		Branch:      data.Branch,
-		Dirty:       data.Dirty,
-		HasStash:    data.HasStash,
-		Ahead:       data.Ahead,
-		Behind:      data.Behind,
-		BaseAhead:   data.BaseAhead,
-		BaseBehind:  data.BaseBehind,
-		UniqueAhead: data.UniqueAhead,
-		Timestamp:   data.Timestamp,
-		Operation:   data.Operation,
-		HeadHash:    data.HeadHash,
+		Name:               wt.Name,
+		Path:               wt.Path,
+		Branch:             data.Branch,
+		Dirty:              data.Dirty,
+		SubmodulesOnly:     data.SubmodulesOnly,
+		HasStash:           data.HasStash,
+		Ahead:              data.Ahead,
+		Behind:             data.Behind,
+		BaseAhead:          data.BaseAhead,
+		BaseBehind:         data.BaseBehind,
+		UniqueAhead:        data.UniqueAhead,
+		Timestamp:          data.Timestamp,
+		Operation:          data.Operation,
+		HeadHash:           data.HeadHash,
+		Subject:            data.Subject,
+		Unborn:             data.Unborn,
+		Locked:             wt.Locked,
+		UpstreamGone:       data.UpstreamGone,
+		Unpushed:           data.Unpushed,
+		TreeMatchesDefault: data.TreeMatchesDefault,
+		PartialErrors:      data.PartialErrors,
 	}
 	status.HasPendingWork = hasPendingWork(status.Dirty, status.HasStash, status.UniqueAhead)
 	return status, nil
 }
 
+// applyStatusDisplay copies per-invocation display toggles onto each row:
+// DisplayPath follows --show-path so the name column can disambiguate similar
+// names, --remote-only/--base-only choose which deltas appear, and --time
+// picks the time column's format.
+func applyStatusDisplay(statuses []*worktreeStatus, root string, opts *statusOptions) {
+	for _, status := range statuses {
+		status.HideUpstream = opts.baseOnly
+		status.HideBase = opts.remoteOnly
+		status.AbsoluteTime = opts.timeFormat == timeFormatAbsolute
+		switch opts.showPath {
+		case showPathAbsolute:
+			status.DisplayPath = status.Path
+		case showPathRelative:
+			rel, err := filepath.Rel(root, status.Path)
+			if err != nil {
+				rel = status.Path
+			}
+			status.DisplayPath = rel
+		default:
+			status.DisplayPath = ""
+		}
+	}
+}
+
+// remoteStatusTargets returns the rows that need PR/CI lookups.
+func remoteStatusTargets(statuses []*worktreeStatus) []*worktreeStatus {
+	targets := make([]*worktreeStatus, 0, len(statuses))
+	for _, status := range statuses {
+		if status != nil && !status.Unborn {
+			targets = append(targets, status)
+		}
+	}
+	return targets
+}
+
+// markSharedBranches flags worktrees whose branch is checked out in more than
+// one worktree; ahead/behind numbers for those are usually misleading.
+func markSharedBranches(statuses []*worktreeStatus) {
+	usage := make(map[string]int)
+	for _, status := range statuses {
+		if branch := sharedBranchKey(status); branch != "" {
+			usage[branch]++
+		}
+	}
+	current := ""
+	for _, status := range statuses {
+		if status != nil && status.Current {
+			current = sharedBranchKey(status)
+		}
+	}
+	for _, status := range statuses {
+		branch := sharedBranchKey(status)
+		status.SharedBranch = branch != "" && usage[branch] > 1
+		status.SharesCurrentBranch = status.SharedBranch && branch == current
+	}
+}
+
+// markTidyThresholds records which rows wt tidy would gray for exceeding the
+// given thresholds. Like tidy, only branches with unique commits are judged.
+func markTidyThresholds(statuses []*worktreeStatus, limits tidyThresholds, now time.Time, defaultBranch string) {
+	if limits.DivergenceCommits == 0 && limits.StaleDays == 0 {
+		return
+	}
+	for _, status := range statuses {
+		if status == nil || status.HasError || status.UniqueAhead == 0 {
+			continue
+		}
+		status.TidyReasons = tidyThresholdReasons(limits, status.BaseAhead, status.BaseBehind, status.Timestamp, now, defaultBranch)
+	}
+}
+
+// markDefaultBranchCheckouts flags worktrees other than the default one that
+// have the default branch checked out. wt tidy blocks these with the same
+// wording.
+func markDefaultBranchCheckouts(statuses []*worktreeStatus, defaultWorktree, defaultBranch string) {
+	for _, status := range statuses {
+		if status == nil {
+			continue
+		}
+		status.OnDefaultBranch = !status.HasError && defaultBranch != "" &&
+			status.Name != defaultWorktree && strings.TrimSpace(status.Branch) == defaultBranch
+	}
+}
+
+func sharedBranchKey(status *worktreeStatus) string {
+	if status == nil || status.HasError {
+		return ""
+	}
+	branch := strings.TrimSpace(status.Branch)
+	if branch == "" || branch == "-" || branch == "HEAD" {
+		return ""
+	}
+	return branch
+}
+
 func hasPendingWork(dirty bool, hasStash bool, uniqueAhead int) bool {
 	return dirty || hasStash || uniqueAhead > 0
 }
@@ -347,15 +808,34 @@ const (
 
 const prLoadingLabel = "PR: loading..."
 
+// unbornLabel fills the detail column for worktrees whose branch has no
+// commits yet; there is nothing to look up on GitHub for them.
+const unbornLabel = "new (no commits)"
+
 const statusColumnCount = 3
 
-var columnMinWidths = [statusColumnCount]int{24, 16, 24}
+// The time column's minimum fits timefmt.AbsoluteLayout as well as the
+// longest relative forms.
+var columnMinWidths = [statusColumnCount]int{24, len(timefmt.AbsoluteLayout), 24}
 var shrinkPriority = []int{2, 0, 1}
 
+// The optional --show-subject column sits between the time and detail
+// columns. It is sized to its content within these bounds.
+const (
+	subjectMinWidth = 12
+	subjectMaxWidth = 40
+)
+
 type columnLayout struct {
-	widths         [statusColumnCount]int
-	useColor       bool
+	widths   [statusColumnCount]int
+	useColor bool
+	// hyperlinks wraps the PR and CI labels in OSC 8 links to GitHub.
+	hyperlinks     bool
 	prDisplayWidth int
+	// subjectWidth is zero unless the commit subject column is shown.
+	subjectWidth int
+	// ageThreshold, when set, highlights rows whose activity is older than it.
+	ageThreshold time.Duration
 }
 
 var (
@@ -363,7 +843,11 @@ var (
 	colorBranchDirty    = color.New(color.FgRed).SprintFunc()
 	colorBranchDiverged = color.New(color.FgMagenta).SprintFunc()
 	colorBranchClean    = color.New(color.FgHiBlack).SprintFunc()
+	colorBranchShared   = color.New(color.FgYellow).SprintFunc()
+	colorBranchConflict = color.New(color.FgHiYellow, color.Bold).SprintFunc()
 	colorTimeValue      = color.New(color.FgHiBlack).SprintFunc()
+	colorTimeStale      = color.New(color.FgYellow).SprintFunc()
+	colorTimeVeryStale  = color.New(color.FgRed).SprintFunc()
 	colorOperation      = color.New(color.FgHiMagenta, color.Bold).SprintFunc()
 	colorPRPending      = color.New(color.FgMagenta).SprintFunc()
 	colorPRMerged       = color.New(color.FgGreen).SprintFunc()
@@ -381,10 +865,53 @@ func (cl columnLayout) totalWidth() int {
 	if len(cl.widths) > 1 {
 		total += (len(cl.widths) - 1) * columnGapWidth
 	}
+	if cl.subjectWidth > 0 {
+		total += cl.subjectWidth + columnGapWidth
+	}
 	return total
 }
 
-func buildColumnLayout(statuses []*worktreeStatus, now time.Time, maxWidth int) columnLayout {
+func buildColumnLayout(statuses []*worktreeStatus, now time.Time, maxWidth int, showSubject bool) columnLayout {
+	subjectWidth := 0
+	if showSubject {
+		subjectWidth = subjectColumnWidth(statuses)
+	}
+	budget := maxWidth
+	if maxWidth > 0 && subjectWidth > 0 {
+		budget = max(maxWidth-subjectWidth-columnGapWidth, 1)
+	}
+	layout := buildBaseColumnLayout(statuses, now, budget)
+	if subjectWidth == 0 {
+		return layout
+	}
+	if maxWidth > 0 {
+		// When the terminal is too narrow for the minimum base columns, give up
+		// subject width first.
+		if over := layout.totalWidth() + subjectWidth + columnGapWidth - maxWidth; over > 0 {
+			subjectWidth -= over
+			if subjectWidth < subjectMinWidth {
+				subjectWidth = subjectMinWidth
+			}
+		}
+	}
+	layout.subjectWidth = subjectWidth
+	return layout
+}
+
+func subjectColumnWidth(statuses []*worktreeStatus) int {
+	width := subjectMinWidth
+	for _, status := range statuses {
+		if w := runewidth.StringWidth(status.Subject); w > width {
+			width = w
+		}
+	}
+	if width > subjectMaxWidth {
+		width = subjectMaxWidth
+	}
+	return width
+}
+
+func buildBaseColumnLayout(statuses []*worktreeStatus, now time.Time, maxWidth int) columnLayout {
 	var widths [statusColumnCount]int
 	var prBaseWidth int
 	mins := columnMinWidths
@@ -462,14 +989,21 @@ func statusFields(status *worktreeStatus, now time.Time, includeSummary bool, pr
 		prefix = "* "
 	}
 	mergedPR := status.PRStatus != "" && strings.Contains(strings.ToLower(status.PRStatus), "merged")
-	branch := formatBranchStatus(status, !mergedPR)
+	branch := formatBranchStatus(status, !mergedPR && !status.HideBase, !status.HideUpstream)
 	nameField := fmt.Sprintf("%s%s", prefix, status.Name)
 	if branch != "" {
 		nameField = fmt.Sprintf("%s  %s", nameField, branch)
 	}
+	if status.DisplayPath != "" {
+		nameField = fmt.Sprintf("%s  (%s)", nameField, status.DisplayPath)
+	}
 	relative := "-"
 	if !status.Timestamp.IsZero() {
-		relative = timefmt.Relative(status.Timestamp, now)
+		if status.AbsoluteTime {
+			relative = timefmt.Absolute(status.Timestamp, now)
+		} else {
+			relative = timefmt.Relative(status.Timestamp, now)
+		}
 	}
 	detail := combineStatusDetail(status.PRStatus, status.CIStatus)
 	if includeSummary {
@@ -487,7 +1021,7 @@ func statusFields(status *worktreeStatus, now time.Time, includeSummary bool, pr
 	}
 }
 
-func formatBranchStatus(status *worktreeStatus, includeBase bool) string {
+func formatBranchStatus(status *worktreeStatus, includeBase, includeUpstream bool) string {
 	branchName := strings.TrimSpace(status.Branch)
 	if branchName == "" {
 		branchName = "-"
@@ -498,19 +1032,55 @@ func formatBranchStatus(status *worktreeStatus, includeBase bool) string {
 	if showBranchName {
 		parts = append(parts, branchName)
 	}
+	switch {
+	case status.SharesCurrentBranch && status.Current:
+		parts = append(parts, "(shared with another worktree)")
+	case status.SharesCurrentBranch:
+		parts = append(parts, "(shared with current)")
+	case status.SharedBranch:
+		parts = append(parts, "(shared)")
+	}
+	if status.OnDefaultBranch {
+		parts = append(parts, "(on default branch)")
+	}
+	if status.Locked {
+		parts = append(parts, "(locked)")
+	}
+	if status.NeedsBootstrap {
+		parts = append(parts, "(no bootstrap)")
+	}
+	if len(status.PartialErrors) > 0 {
+		parts = append(parts, "(partial)")
+	}
+	if len(status.TidyReasons) > 0 {
+		parts = append(parts, "(would-tidy)")
+	}
 	if status.Dirty {
-		parts = append(parts, "dirty")
+		if status.SubmodulesOnly {
+			parts = append(parts, "sub")
+		} else {
+			parts = append(parts, "dirty")
+		}
 	}
 	if status.Operation != "" {
 		parts = append(parts, fmt.Sprintf("(%s)", status.Operation))
 	}
-	if delta := formatDelta(status.Ahead, status.Behind); delta != "" {
-		parts = append(parts, delta)
+	if includeUpstream {
+		if status.UpstreamGone {
+			parts = append(parts, "(upstream gone)")
+		} else if status.Unpushed {
+			parts = append(parts, "(unpushed)")
+		} else if delta := formatDelta(status.Ahead, status.Behind); delta != "" {
+			parts = append(parts, delta)
+		}
 	}
 	if includeBase {
 		if base := formatBaseDelta(status.BaseAhead, status.BaseBehind); base != "" {
 			parts = append(parts, base)
 		}
+		if status.noTreeDiff() {
+			parts = append(parts, "(no tree diff)")
+		}
 	}
 	if len(parts) == 0 {
 		return ""
@@ -518,6 +1088,13 @@ func formatBranchStatus(status *worktreeStatus, includeBase bool) string {
 	return strings.Join(parts, " ")
 }
 
+// noTreeDiff reports a branch with commits ahead of the base whose files are
+// nonetheless identical to the default branch, typically because the work
+// landed there through a squash or rebase.
+func (s *worktreeStatus) noTreeDiff() bool {
+	return s.TreeMatchesDefault && s.BaseAhead > 0
+}
+
 func formatDelta(ahead, behind int) string {
 	parts := make([]string, 0, 2)
 	if ahead > 0 {
@@ -613,6 +1190,14 @@ func formatStatusLine(status *worktreeStatus, now time.Time, layout columnLayout
 	}
 	if layout.useColor {
 		colorizeParts(parts, status)
+		parts[1] = chooseTimeColor(status.Timestamp, now, layout.ageThreshold)(parts[1])
+	}
+	if layout.hyperlinks {
+		parts[2] = linkStatusDetail(parts[2], status)
+	}
+	if layout.subjectWidth > 0 {
+		subject := padOrTrim(status.Subject, layout.subjectWidth)
+		parts = append(parts[:2], append([]string{subject}, parts[2:]...)...)
 	}
 	return strings.Join(parts, columnGap)
 }
@@ -620,6 +1205,10 @@ func formatStatusLine(status *worktreeStatus, now time.Time, layout columnLayout
 type statusRenderer struct {
 	w     *os.File
 	lines int
+	// resized is set from the SIGWINCH watcher; the next Render clears the
+	// screen because the terminal may have rewrapped the previous rows.
+	resized atomic.Bool
+	clear   bool
 }
 
 func newStatusRenderer(writer io.Writer) *statusRenderer {
@@ -638,7 +1227,10 @@ func (r *statusRenderer) Render(statuses []*worktreeStatus, layout columnLayout,
 		return
 	}
 	lines := formatStatusLines(statuses, now, layout)
-	if r.lines > 0 {
+	if r.clear {
+		fmt.Fprint(r.w, "\x1b[H\x1b[2J")
+		r.clear = false
+	} else if r.lines > 0 {
 		fmt.Fprintf(r.w, "\x1b[%dA", r.lines)
 		fmt.Fprint(r.w, "\r\x1b[J")
 	}
@@ -648,6 +1240,20 @@ func (r *statusRenderer) Render(statuses []*worktreeStatus, layout columnLayout,
 	r.lines = len(lines)
 }
 
+func (r *statusRenderer) markResized() {
+	r.resized.Store(true)
+}
+
+// takeResize reports whether the terminal was resized since the last call and
+// arranges for the next Render to repaint from a cleared screen.
+func (r *statusRenderer) takeResize() bool {
+	if r == nil || !r.resized.Swap(false) {
+		return false
+	}
+	r.clear = true
+	return true
+}
+
 func (r *statusRenderer) AddExtraLines(n int) {
 	if r == nil || n <= 0 {
 		return
@@ -662,6 +1268,10 @@ func colorizeParts(parts []string, status *worktreeStatus) {
 		branchColor = colorPRError
 	case status.Operation != "":
 		branchColor = colorOperation
+	case status.SharesCurrentBranch:
+		branchColor = colorBranchConflict
+	case status.SharedBranch, status.OnDefaultBranch:
+		branchColor = colorBranchShared
 	case status.Dirty:
 		branchColor = colorBranchDirty
 	case status.Ahead > 0 || status.Behind > 0:
@@ -672,10 +1282,26 @@ func colorizeParts(parts []string, status *worktreeStatus) {
 	} else {
 		parts[0] = branchColor(parts[0])
 	}
-	parts[1] = colorTimeValue(parts[1])
 	parts[2] = chooseStatusColor(status)(parts[2])
 }
 
+// chooseTimeColor turns the time column into a staleness heatmap when --age is
+// set: yellow past the threshold, red past twice the threshold.
+func chooseTimeColor(ts, now time.Time, threshold time.Duration) func(a ...interface{}) string {
+	if threshold <= 0 || ts.IsZero() {
+		return colorTimeValue
+	}
+	age := now.Sub(ts)
+	switch {
+	case age > 2*threshold:
+		return colorTimeVeryStale
+	case age > threshold:
+		return colorTimeStale
+	default:
+		return colorTimeValue
+	}
+}
+
 func chooseStatusColor(status *worktreeStatus) func(a ...interface{}) string {
 	if status.HasError {
 		return colorPRError
@@ -700,8 +1326,12 @@ func chooseStatusColor(status *worktreeStatus) func(a ...interface{}) string {
 func choosePRStringColor(prText string) func(a ...interface{}) string {
 	pr := strings.ToLower(prText)
 	switch {
-	case strings.Contains(pr, "merged"):
+	case strings.Contains(pr, prConflictsMarker):
+		return colorPRError
+	case strings.Contains(pr, "merged"), strings.Contains(pr, "approved"):
 		return colorPRMerged
+	case strings.Contains(pr, "changes"):
+		return colorPRError
 	case strings.Contains(pr, "open"), strings.Contains(pr, "draft"), strings.Contains(pr, "pending"):
 		return colorPRPending
 	case strings.Contains(pr, "none"):
@@ -713,6 +1343,14 @@ func choosePRStringColor(prText string) func(a ...interface{}) string {
 	}
 }
 
+// limitStatuses returns the first limit rows, or all of them when limit is 0.
+func limitStatuses(statuses []*worktreeStatus, limit int) []*worktreeStatus {
+	if limit > 0 && len(statuses) > limit {
+		return statuses[:limit]
+	}
+	return statuses
+}
+
 func printStatuses(w io.Writer, statuses []*worktreeStatus, now time.Time, layout columnLayout) {
 	for _, status := range statuses {
 		fmt.Fprintln(w, formatStatusLine(status, now, layout))
@@ -744,6 +1382,70 @@ func printCIDetail(w io.Writer, statuses []*worktreeStatus, now time.Time) {
 	}
 }
 
+// printCIFailures lists worktrees whose CI is red, one line per failing check,
+// and returns how many worktrees failed.
+func printCIFailures(w io.Writer, statuses []*worktreeStatus) int {
+	failed := 0
+	for _, status := range statuses {
+		if status.CIState != ciStateFailure {
+			continue
+		}
+		failed++
+		if len(status.CIDetail) == 0 {
+			fmt.Fprintf(w, "%s: CI failed\n", status.Name)
+			continue
+		}
+		for _, run := range status.CIDetail {
+			name := strings.TrimSpace(run.Name)
+			if name == "" {
+				name = "CI failed"
+			}
+			line := fmt.Sprintf("%s: %s", status.Name, name)
+			if run.URL != "" {
+				line += " " + run.URL
+			}
+			fmt.Fprintln(w, line)
+		}
+	}
+	return failed
+}
+
+// statusHasProblem selects the rows wt status --errors-only keeps: collection
+// errors, failing CI, and the tidy dashboard's prompt and process warnings.
+func statusHasProblem(status *worktreeStatus) bool {
+	return status.HasError || status.NeedsInput || status.ProcessWarn || status.CIState == ciStateFailure
+}
+
+// countOperationsInProgress counts worktrees stuck mid-rebase, mid-merge, etc.
+func countOperationsInProgress(statuses []*worktreeStatus) int {
+	count := 0
+	for _, status := range statuses {
+		if status.Operation != "" {
+			count++
+		}
+	}
+	return count
+}
+
+// printOperationsInProgress adds a reminder line for worktrees stuck mid-rebase,
+// mid-merge, etc., since those are easy to forget about. It returns how many
+// worktrees were listed.
+func printOperationsInProgress(w io.Writer, statuses []*worktreeStatus) int {
+	entries := make([]string, 0)
+	for _, status := range statuses {
+		if status.Operation == "" {
+			continue
+		}
+		entries = append(entries, fmt.Sprintf("%s (%s)", status.Name, status.Operation))
+	}
+	if len(entries) == 0 {
+		return 0
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "In progress: %s\n", strings.Join(entries, ", "))
+	return len(entries)
+}
+
 func formatCIDetailTimes(run ciRunSummary, now time.Time) string {
 	parts := make([]string, 0, 2)
 	if !run.StartedAt.IsZero() {
@@ -772,7 +1474,7 @@ func formatStatusLines(statuses []*worktreeStatus, now time.Time, layout columnL
 	return lines
 }
 
-func fetchPullRequestStatuses(ctx context.Context, repo *githubRepo, repoErr error, statuses []*worktreeStatus, workflow workflowExpectations, onUpdate func(*worktreeStatus)) error {
+func fetchPullRequestStatuses(ctx context.Context, repo *forgeRepo, repoErr error, statuses []*worktreeStatus, workflow workflowExpectations, prLimit int, onUpdate func(*worktreeStatus)) error {
 	if len(statuses) == 0 {
 		return nil
 	}
@@ -786,12 +1488,16 @@ func fetchPullRequestStatuses(ctx context.Context, repo *githubRepo, repoErr err
 			prs, err := func() ([]pullRequestInfo, error) {
 				region := trace.StartRegion(ctx, "pr "+status.Name)
 				defer region.End()
-				return queryPullRequests(ctx, status.Path, status.Branch)
+				return queryPullRequests(ctx, repo, status.Path, status.Branch, prLimit)
 			}()
 			if errors.Is(err, context.Canceled) {
 				markPRInterrupted(statuses, onUpdate)
 				return err
 			}
+			if errors.Is(err, context.DeadlineExceeded) {
+				markPRTimedOut(statuses, onUpdate)
+				return err
+			}
 			if err != nil {
 				msg := singleLineError(err)
 				if msg == "" {
@@ -808,6 +1514,7 @@ func fetchPullRequestStatuses(ctx context.Context, repo *githubRepo, repoErr err
 			summary := summarizePullRequestState(prContext{
 				HasPendingWork:   status.HasPendingWork,
 				HasUniqueCommits: status.UniqueAhead > 0,
+				Truncated:        len(prs) >= prLimit,
 			}, prs, workflow)
 			status.PRStatus = summary.Column
 			if onUpdate != nil {
@@ -817,7 +1524,7 @@ func fetchPullRequestStatuses(ctx context.Context, repo *githubRepo, repoErr err
 		return combined
 	}
 
-	if repo != nil && repoErr == nil {
+	if repo != nil && repoErr == nil && repo.usesGh() {
 		need := make([]*worktreeStatus, 0, len(statuses))
 		byBranch := make(map[string][]*worktreeStatus)
 		branches := make([]string, 0, len(statuses))
@@ -840,11 +1547,15 @@ func fetchPullRequestStatuses(ctx context.Context, repo *githubRepo, repoErr err
 			branches = append(branches, branch)
 		}
 
-		prsByBranch, err := queryPullRequestsGraphQL(ctx, "", repo, branches)
+		prsByBranch, err := queryPullRequestsGraphQL(ctx, "", repo, branches, prLimit)
 		if errors.Is(err, context.Canceled) {
 			markPRInterrupted(statuses, onUpdate)
 			return err
 		}
+		if errors.Is(err, context.DeadlineExceeded) {
+			markPRTimedOut(statuses, onUpdate)
+			return err
+		}
 		if err != nil {
 			msg := singleLineError(err)
 			if msg == "" {
@@ -865,6 +1576,7 @@ func fetchPullRequestStatuses(ctx context.Context, repo *githubRepo, repoErr err
 			summary := summarizePullRequestState(prContext{
 				HasPendingWork:   status.HasPendingWork,
 				HasUniqueCommits: status.UniqueAhead > 0,
+				Truncated:        len(prs) >= prLimit,
 			}, prs, workflow)
 			status.PRStatus = summary.Column
 			if onUpdate != nil {
@@ -893,9 +1605,9 @@ func fetchPullRequestStatuses(ctx context.Context, repo *githubRepo, repoErr err
 			prs, err := func() ([]pullRequestInfo, error) {
 				region := trace.StartRegion(ctx, "pr "+status.Name)
 				defer region.End()
-				return queryPullRequests(ctx, status.Path, status.Branch)
+				return queryPullRequests(ctx, repo, status.Path, status.Branch, prLimit)
 			}()
-			if errors.Is(err, context.Canceled) {
+			if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
 				return
 			}
 			results <- prResult{status: status, prs: prs, err: err}
@@ -913,6 +1625,8 @@ func fetchPullRequestStatuses(ctx context.Context, repo *githubRepo, repoErr err
 			err := ctx.Err()
 			if errors.Is(err, context.Canceled) {
 				markPRInterrupted(statuses, onUpdate)
+			} else if errors.Is(err, context.DeadlineExceeded) {
+				markPRTimedOut(statuses, onUpdate)
 			}
 			return err
 		case res, ok := <-results:
@@ -935,6 +1649,7 @@ func fetchPullRequestStatuses(ctx context.Context, repo *githubRepo, repoErr err
 			summary := summarizePullRequestState(prContext{
 				HasPendingWork:   res.status.HasPendingWork,
 				HasUniqueCommits: res.status.UniqueAhead > 0,
+				Truncated:        len(res.prs) >= prLimit,
 			}, res.prs, workflow)
 			res.status.PRStatus = summary.Column
 			if onUpdate != nil {
@@ -945,6 +1660,14 @@ func fetchPullRequestStatuses(ctx context.Context, repo *githubRepo, repoErr err
 }
 
 func markPRInterrupted(statuses []*worktreeStatus, onUpdate func(*worktreeStatus)) {
+	markPRUnfinished(statuses, prInterruptedLabel, onUpdate)
+}
+
+func markPRTimedOut(statuses []*worktreeStatus, onUpdate func(*worktreeStatus)) {
+	markPRUnfinished(statuses, prTimeoutLabel, onUpdate)
+}
+
+func markPRUnfinished(statuses []*worktreeStatus, label string, onUpdate func(*worktreeStatus)) {
 	for _, status := range statuses {
 		if status == nil {
 			continue
@@ -957,13 +1680,38 @@ func markPRInterrupted(statuses []*worktreeStatus, onUpdate func(*worktreeStatus
 		default:
 			continue
 		}
-		status.PRStatus = prInterruptedLabel
+		status.PRStatus = label
 		if onUpdate != nil {
 			onUpdate(status)
 		}
 	}
 }
 
+func warnGitHubFetchAborted(cmd *cobra.Command, err error, timeout time.Duration) {
+	switch {
+	case err == nil:
+	case errors.Is(err, context.Canceled):
+		fmt.Fprintln(cmd.ErrOrStderr(), "warning: cancelled GitHub fetch")
+	case errors.Is(err, context.DeadlineExceeded):
+		fmt.Fprintf(cmd.ErrOrStderr(), "warning: GitHub fetch timed out after %s (see [gh].timeout)\n", timeout)
+	}
+}
+
+// hintStaleFetch suggests --fetch when the last fetch is older than
+// status.fetch_warn_age, since upstream and base deltas are only as fresh as
+// the remote-tracking refs. Repositories that never fetched stay quiet.
+func hintStaleFetch(w io.Writer, proj *project.Project, now time.Time) {
+	threshold := proj.Config.Status.FetchWarnAgeDuration()
+	if threshold <= 0 || proj.DefaultWorktreePath == "" {
+		return
+	}
+	last, ok, err := gitutil.LastFetchTime(proj.DefaultWorktreePath)
+	if err != nil || !ok || now.Sub(last) <= threshold {
+		return
+	}
+	fmt.Fprintf(w, "hint: origin last fetched %s; ahead/behind may be stale (run `wt status --fetch`)\n", timefmt.Relative(last, now))
+}
+
 func statusPreflight(cmd *cobra.Command) {
 	if shellbridge.Active() && shellbridge.InstructionFile() != "" {
 		return
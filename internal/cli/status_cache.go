@@ -0,0 +1,183 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// statusCacheEntry holds the GitHub-derived fields of one status row. Entries
+// are only reused while the worktree still points at the same branch and HEAD.
+type statusCacheEntry struct {
+	Branch       string            `json:"branch"`
+	Head         string            `json:"head"`
+	FetchedAt    time.Time         `json:"fetched_at"`
+	PRStatus     string            `json:"pr_status"`
+	PullRequests []pullRequestInfo `json:"pull_requests"`
+	CIStatus     string            `json:"ci_status"`
+	CIState      ciState           `json:"ci_state"`
+	CIDetail     []ciRunSummary    `json:"ci_detail"`
+	CIRuns       []ciRunSummary    `json:"ci_runs,omitempty"`
+	CIMergeRef   bool              `json:"ci_merge_ref,omitempty"`
+}
+
+// statusCache is shared between concurrent `wt status --interval-cache`
+// invocations (e.g. several shell prompts) so they coalesce gh calls.
+type statusCache struct {
+	Entries map[string]statusCacheEntry `json:"entries"`
+}
+
+func statusCachePaths(root string) (dataPath, lockPath string) {
+	dir := filepath.Join(root, ".wt", "cache")
+	return filepath.Join(dir, "status.json"), filepath.Join(dir, "status.lock")
+}
+
+func loadStatusCache(path string) (*statusCache, error) {
+	cache := &statusCache{Entries: map[string]statusCacheEntry{}}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return cache, nil
+	} else if err != nil {
+		return cache, err
+	}
+	if err := json.Unmarshal(data, cache); err != nil {
+		// A corrupt cache is just a cold cache.
+		return &statusCache{Entries: map[string]statusCacheEntry{}}, nil
+	}
+	if cache.Entries == nil {
+		cache.Entries = map[string]statusCacheEntry{}
+	}
+	return cache, nil
+}
+
+func saveStatusCache(path string, cache *statusCache) error {
+	data, err := json.Marshal(cache)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".status-*.json")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// apply fills statuses from fresh cache entries and returns the rows that
+// still need to be fetched from GitHub.
+func (c *statusCache) apply(statuses []*worktreeStatus, now time.Time, maxAge time.Duration) []*worktreeStatus {
+	pending := make([]*worktreeStatus, 0, len(statuses))
+	for _, status := range statuses {
+		if status == nil {
+			continue
+		}
+		if status.HasError || status.Error != "" {
+			pending = append(pending, status)
+			continue
+		}
+		entry, ok := c.Entries[status.Path]
+		if !ok || entry.Branch != status.Branch || entry.Head != status.HeadHash || now.Sub(entry.FetchedAt) >= maxAge {
+			pending = append(pending, status)
+			continue
+		}
+		status.PRStatus = entry.PRStatus
+		status.PullRequests = append([]pullRequestInfo(nil), entry.PullRequests...)
+		status.CIStatus = entry.CIStatus
+		status.CIState = entry.CIState
+		status.CIDetail = append([]ciRunSummary(nil), entry.CIDetail...)
+		status.CIRuns = append([]ciRunSummary(nil), entry.CIRuns...)
+		status.CIMergeRef = entry.CIMergeRef
+	}
+	return pending
+}
+
+// record stores freshly fetched rows, skipping ones whose lookups did not
+// finish cleanly so a timeout is never served to other shells.
+func (c *statusCache) record(statuses []*worktreeStatus, now time.Time) {
+	for _, status := range statuses {
+		if status == nil || !statusCacheable(status) {
+			continue
+		}
+		c.Entries[status.Path] = statusCacheEntry{
+			Branch:       status.Branch,
+			Head:         status.HeadHash,
+			FetchedAt:    now,
+			PRStatus:     status.PRStatus,
+			PullRequests: status.PullRequests,
+			CIStatus:     status.CIStatus,
+			CIState:      status.CIState,
+			CIDetail:     status.CIDetail,
+			CIRuns:       status.CIRuns,
+			CIMergeRef:   status.CIMergeRef,
+		}
+	}
+}
+
+func statusCacheable(status *worktreeStatus) bool {
+	if status.HasError || status.Error != "" || status.HeadHash == "" {
+		return false
+	}
+	switch status.PRStatus {
+	case prLoadingLabel, prInterruptedLabel, prTimeoutLabel:
+		return false
+	}
+	if strings.HasPrefix(status.PRStatus, "PR: unavailable") {
+		return false
+	}
+	switch status.CIStatus {
+	case ciInterruptedLabel, ciTimeoutLabel:
+		return false
+	}
+	return status.CIState != ciStateError
+}
+
+// lockStatusCache takes the cache's inter-process lock, polling until wait
+// elapses or ctx is cancelled. Holding the lock across the gh phases means a
+// second invocation waits for the first and then reads its results instead
+// of issuing the same queries.
+func lockStatusCache(ctx context.Context, path string, wait time.Duration) (func(), error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	deadline := time.Now().Add(wait)
+	for {
+		ok, err := tryLockFile(f)
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+		if ok {
+			return func() {
+				unlockFile(f)
+				f.Close()
+			}, nil
+		}
+		if time.Now().After(deadline) {
+			f.Close()
+			return nil, fmt.Errorf("timed out after %s waiting for status cache lock", wait)
+		}
+		select {
+		case <-ctx.Done():
+			f.Close()
+			return nil, ctx.Err()
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+}
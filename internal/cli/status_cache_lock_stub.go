@@ -0,0 +1,13 @@
+//go:build windows
+
+package cli
+
+import "os"
+
+// Without flock the cache still works; concurrent invocations may simply
+// duplicate gh queries.
+func tryLockFile(*os.File) (bool, error) {
+	return true, nil
+}
+
+func unlockFile(*os.File) {}
@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestStatusCacheRoundTripReusesFreshEntries(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "status.json")
+	now := time.Date(2024, time.March, 14, 15, 0, 0, 0, time.UTC)
+
+	fetched := []*worktreeStatus{
+		{Name: "alpha", Path: "/repo/alpha", Branch: "alpha", HeadHash: "aaa", PRStatus: "PR #7 open", CIStatus: "CI✓", CIState: ciStateSuccess,
+			PullRequests: []pullRequestInfo{{Number: 7, State: "OPEN"}}},
+		{Name: "beta", Path: "/repo/beta", Branch: "beta", HeadHash: "bbb", PRStatus: prTimeoutLabel},
+	}
+	cache, err := loadStatusCache(path)
+	if err != nil {
+		t.Fatalf("loadStatusCache: %v", err)
+	}
+	cache.record(fetched, now)
+	if err := saveStatusCache(path, cache); err != nil {
+		t.Fatalf("saveStatusCache: %v", err)
+	}
+
+	cache, err = loadStatusCache(path)
+	if err != nil {
+		t.Fatalf("loadStatusCache: %v", err)
+	}
+	next := []*worktreeStatus{
+		{Name: "alpha", Path: "/repo/alpha", Branch: "alpha", HeadHash: "aaa", PRStatus: prLoadingLabel},
+		{Name: "beta", Path: "/repo/beta", Branch: "beta", HeadHash: "bbb", PRStatus: prLoadingLabel},
+	}
+	pending := cache.apply(next, now.Add(10*time.Second), 30*time.Second)
+	if len(pending) != 1 || pending[0].Name != "beta" {
+		t.Fatalf("pending = %v, want only beta (timeouts are not cached)", pending)
+	}
+	if next[0].PRStatus != "PR #7 open" || next[0].CIState != ciStateSuccess || len(next[0].PullRequests) != 1 {
+		t.Fatalf("alpha not filled from cache: %+v", next[0])
+	}
+
+	moved := []*worktreeStatus{{Name: "alpha", Path: "/repo/alpha", Branch: "alpha", HeadHash: "ccc"}}
+	if pending := cache.apply(moved, now.Add(10*time.Second), 30*time.Second); len(pending) != 1 {
+		t.Fatalf("expected new HEAD to miss the cache")
+	}
+	stale := []*worktreeStatus{{Name: "alpha", Path: "/repo/alpha", Branch: "alpha", HeadHash: "aaa"}}
+	if pending := cache.apply(stale, now.Add(time.Minute), 30*time.Second); len(pending) != 1 {
+		t.Fatalf("expected expired entry to miss the cache")
+	}
+}
+
+func TestLockStatusCacheExcludesConcurrentHolders(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache", "status.lock")
+	unlock, err := lockStatusCache(context.Background(), path, time.Second)
+	if err != nil {
+		t.Fatalf("lockStatusCache: %v", err)
+	}
+	if _, err := lockStatusCache(context.Background(), path, 100*time.Millisecond); err == nil {
+		t.Fatalf("expected second lock to time out while the first is held")
+	}
+	unlock()
+	unlock2, err := lockStatusCache(context.Background(), path, time.Second)
+	if err != nil {
+		t.Fatalf("lock after release: %v", err)
+	}
+	unlock2()
+}
@@ -0,0 +1,109 @@
+package cli
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+// hyperlinkTermPrograms lists TERM_PROGRAM values of terminals known to
+// render OSC 8 hyperlinks.
+var hyperlinkTermPrograms = map[string]bool{
+	"iTerm.app": true,
+	"WezTerm":   true,
+	"vscode":    true,
+	"ghostty":   true,
+	"Hyper":     true,
+}
+
+// hyperlinksSupported reports whether the terminal should get OSC 8
+// hyperlinks. WT_HYPERLINKS wins, then FORCE_HYPERLINK (the convention other
+// CLIs share); either may be 0/false to opt out. Without them, wt guesses from
+// the terminal's own environment variables. Callers still require a TTY.
+func hyperlinksSupported(getenv func(string) string) bool {
+	for _, key := range []string{"WT_HYPERLINKS", "FORCE_HYPERLINK"} {
+		value := strings.TrimSpace(getenv(key))
+		if value == "" {
+			continue
+		}
+		if on, err := strconv.ParseBool(value); err == nil {
+			return on
+		}
+		return true
+	}
+	if hyperlinkTermPrograms[getenv("TERM_PROGRAM")] {
+		return true
+	}
+	switch getenv("TERM") {
+	case "xterm-kitty", "xterm-ghostty":
+		return true
+	}
+	if getenv("WT_SESSION") != "" || getenv("KITTY_WINDOW_ID") != "" {
+		return true
+	}
+	// VTE (GNOME Terminal, Tilix, ...) added OSC 8 in 0.50, reported as 5000.
+	if vte, err := strconv.Atoi(getenv("VTE_VERSION")); err == nil && vte >= 5000 {
+		return true
+	}
+	return false
+}
+
+func terminalHyperlinksSupported() bool {
+	return hyperlinksSupported(os.Getenv)
+}
+
+// hyperlink wraps text in an OSC 8 escape sequence pointing at url.
+func hyperlink(url, text string) string {
+	if url == "" || text == "" {
+		return text
+	}
+	return "\x1b]8;;" + url + "\x1b\\" + text + "\x1b]8;;\x1b\\"
+}
+
+// statusPRURL returns the pull request the PR cell describes: the first open
+// one, or else the most recent.
+func statusPRURL(status *worktreeStatus) string {
+	if open := openPullRequests(status.PullRequests); len(open) > 0 {
+		return open[0].URL
+	}
+	if len(status.PullRequests) > 0 {
+		return status.PullRequests[0].URL
+	}
+	return ""
+}
+
+// statusCIURL returns the run behind the CI cell, which is only recorded for
+// failures.
+func statusCIURL(status *worktreeStatus) string {
+	for _, run := range status.CIDetail {
+		if run.URL != "" {
+			return run.URL
+		}
+	}
+	return ""
+}
+
+// linkStatusDetail turns the PR and CI labels inside an already padded detail
+// cell into hyperlinks. It runs after padding because the escape sequences
+// have no display width; a label that was truncated away stays plain.
+func linkStatusDetail(cell string, status *worktreeStatus) string {
+	var b strings.Builder
+	rest := cell
+	for _, seg := range []struct{ text, url string }{
+		{strings.TrimSpace(status.PRStatus), statusPRURL(status)},
+		{strings.TrimSpace(status.CIStatus), statusCIURL(status)},
+	} {
+		if seg.text == "" || seg.url == "" {
+			continue
+		}
+		idx := strings.Index(rest, seg.text)
+		if idx < 0 {
+			continue
+		}
+		b.WriteString(rest[:idx])
+		b.WriteString(hyperlink(seg.url, seg.text))
+		rest = rest[idx+len(seg.text):]
+	}
+	b.WriteString(rest)
+	return b.String()
+}
@@ -0,0 +1,266 @@
+package cli
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+)
+
+// statusJSONVersion identifies the `wt status --json` schema. Bump it whenever
+// a field is removed or changes meaning; adding fields is not a breaking change.
+const statusJSONVersion = 1
+
+type statusJSONDocument struct {
+	Version   int                  `json:"version"`
+	Worktrees []statusJSONWorktree `json:"worktrees"`
+}
+
+type statusJSONWorktree struct {
+	Name            string                  `json:"name"`
+	Path            string                  `json:"path"`
+	Branch          string                  `json:"branch"`
+	Current         bool                    `json:"current"`
+	Head            string                  `json:"head,omitempty"`
+	Subject         string                  `json:"subject,omitempty"`
+	Dirty           bool                    `json:"dirty"`
+	HasStash        bool                    `json:"has_stash"`
+	Ahead           int                     `json:"ahead"`
+	Behind          int                     `json:"behind"`
+	BaseAhead       int                     `json:"base_ahead"`
+	BaseBehind      int                     `json:"base_behind"`
+	UniqueAhead     int                     `json:"unique_ahead"`
+	SharedBranch    bool                    `json:"shared_branch"`
+	SharesCurrent   bool                    `json:"shares_current_branch"`
+	OnDefaultBranch bool                    `json:"on_default_branch"`
+	Locked          bool                    `json:"locked"`
+	UpstreamGone    bool                    `json:"upstream_gone"`
+	Unpushed        bool                    `json:"unpushed"`
+	NoTreeDiff      bool                    `json:"no_tree_diff"`
+	NeedsBootstrap  bool                    `json:"needs_bootstrap"`
+	WouldTidy       []string                `json:"would_tidy,omitempty"`
+	Operation       string                  `json:"operation,omitempty"`
+	Timestamp       *time.Time              `json:"timestamp,omitempty"`
+	PRStatus        string                  `json:"pr_status,omitempty"`
+	PullRequests    []statusJSONPullRequest `json:"pull_requests"`
+	CI              statusJSONCI            `json:"ci"`
+	Processes       []statusJSONProcess     `json:"processes"`
+	Error           string                  `json:"error,omitempty"`
+	PartialErrors   []statusJSONFieldError  `json:"partial_errors,omitempty"`
+}
+
+type statusJSONFieldError struct {
+	Field string `json:"field"`
+	Error string `json:"error"`
+}
+
+type statusJSONPullRequest struct {
+	Number         int        `json:"number"`
+	State          string     `json:"state"`
+	IsDraft        bool       `json:"is_draft"`
+	URL            string     `json:"url,omitempty"`
+	UpdatedAt      *time.Time `json:"updated_at,omitempty"`
+	ReviewDecision string     `json:"review_decision,omitempty"`
+	// Mergeable and MergeStateStatus pass GitHub's values through, e.g.
+	// CONFLICTING and DIRTY.
+	Mergeable        string `json:"mergeable,omitempty"`
+	MergeStateStatus string `json:"merge_state_status,omitempty"`
+	Conflicts        bool   `json:"conflicts"`
+}
+
+type statusJSONCI struct {
+	State   string `json:"state"`
+	Summary string `json:"summary,omitempty"`
+	// MergeRef reports that the result came from the open PR's merge ref
+	// (refs/pull/<n>/merge) rather than the branch HEAD.
+	MergeRef bool            `json:"merge_ref"`
+	Details  []statusJSONRun `json:"details"`
+}
+
+// statusJSONRun is one workflow run or check behind the CI summary; details
+// lists every run that fed it, passing ones included.
+type statusJSONRun struct {
+	Name        string     `json:"name"`
+	Status      string     `json:"status"`
+	Conclusion  string     `json:"conclusion,omitempty"`
+	URL         string     `json:"url,omitempty"`
+	StartedAt   *time.Time `json:"started_at,omitempty"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+type statusJSONProcess struct {
+	PID     int    `json:"pid"`
+	Command string `json:"command"`
+	CWD     string `json:"cwd"`
+}
+
+func (s ciState) String() string {
+	switch s {
+	case ciStateSuccess:
+		return "success"
+	case ciStatePending:
+		return "pending"
+	case ciStateFailure:
+		return "failure"
+	case ciStateWarning:
+		return "warning"
+	case ciStateError:
+		return "error"
+	default:
+		return "unknown"
+	}
+}
+
+func buildStatusJSON(statuses []*worktreeStatus) statusJSONDocument {
+	doc := statusJSONDocument{
+		Version:   statusJSONVersion,
+		Worktrees: make([]statusJSONWorktree, 0, len(statuses)),
+	}
+	for _, status := range statuses {
+		if status == nil {
+			continue
+		}
+		doc.Worktrees = append(doc.Worktrees, statusJSONEntry(status))
+	}
+	return doc
+}
+
+func statusJSONEntry(status *worktreeStatus) statusJSONWorktree {
+	entry := statusJSONWorktree{
+		Name:            status.Name,
+		Path:            status.Path,
+		Branch:          status.Branch,
+		Current:         status.Current,
+		Head:            status.HeadHash,
+		Subject:         status.Subject,
+		Dirty:           status.Dirty,
+		HasStash:        status.HasStash,
+		Ahead:           status.Ahead,
+		Behind:          status.Behind,
+		BaseAhead:       status.BaseAhead,
+		BaseBehind:      status.BaseBehind,
+		UniqueAhead:     status.UniqueAhead,
+		SharedBranch:    status.SharedBranch,
+		SharesCurrent:   status.SharesCurrentBranch,
+		OnDefaultBranch: status.OnDefaultBranch,
+		Locked:          status.Locked,
+		UpstreamGone:    status.UpstreamGone,
+		Unpushed:        status.Unpushed,
+		NoTreeDiff:      status.noTreeDiff(),
+		NeedsBootstrap:  status.NeedsBootstrap,
+		WouldTidy:       status.TidyReasons,
+		Operation:       status.Operation,
+		Timestamp:       optionalTime(status.Timestamp),
+		PRStatus:        status.PRStatus,
+		PullRequests:    make([]statusJSONPullRequest, 0, len(status.PullRequests)),
+		CI: statusJSONCI{
+			State:    status.CIState.String(),
+			Summary:  status.CIStatus,
+			MergeRef: status.CIMergeRef,
+			Details:  make([]statusJSONRun, 0, len(status.CIRuns)),
+		},
+		Processes: make([]statusJSONProcess, 0, len(status.Processes)),
+		Error:     status.Error,
+	}
+	for _, pr := range status.PullRequests {
+		entry.PullRequests = append(entry.PullRequests, statusJSONPullRequest{
+			Number:           pr.Number,
+			State:            pr.State,
+			IsDraft:          pr.IsDraft,
+			URL:              pr.URL,
+			UpdatedAt:        optionalTime(pr.UpdatedAt),
+			ReviewDecision:   pr.ReviewDecision,
+			Mergeable:        pr.Mergeable,
+			MergeStateStatus: pr.MergeStateStatus,
+			Conflicts:        pr.Conflicting(),
+		})
+	}
+	for _, fe := range status.PartialErrors {
+		entry.PartialErrors = append(entry.PartialErrors, statusJSONFieldError{
+			Field: fe.Field,
+			Error: singleLineError(fe.Err),
+		})
+	}
+	for _, run := range status.CIRuns {
+		entry.CI.Details = append(entry.CI.Details, statusJSONRun{
+			Name:        run.Name,
+			Status:      run.Status,
+			Conclusion:  run.Conclusion,
+			URL:         run.URL,
+			StartedAt:   optionalTime(run.StartedAt),
+			CompletedAt: optionalTime(run.CompletedAt),
+		})
+	}
+	for _, proc := range status.Processes {
+		entry.Processes = append(entry.Processes, statusJSONProcess{
+			PID:     proc.PID,
+			Command: proc.Command,
+			CWD:     proc.CWD,
+		})
+	}
+	return entry
+}
+
+func writeStatusJSON(w io.Writer, statuses []*worktreeStatus) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(buildStatusJSON(statuses))
+}
+
+// statusJSONStream writes `wt status --json --stream` output: one compact
+// statusJSONWorktree per line, each emitted once, as soon as that worktree has
+// nothing left to fetch. Callbacks from concurrent fetches may emit.
+type statusJSONStream struct {
+	mu      sync.Mutex
+	enc     *json.Encoder
+	emitted map[*worktreeStatus]bool
+	err     error
+}
+
+func newStatusJSONStream(w io.Writer) *statusJSONStream {
+	return &statusJSONStream{enc: json.NewEncoder(w), emitted: make(map[*worktreeStatus]bool)}
+}
+
+func (s *statusJSONStream) emit(status *worktreeStatus) {
+	if s == nil || status == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.emitted[status] || s.err != nil {
+		return
+	}
+	s.emitted[status] = true
+	s.err = s.enc.Encode(statusJSONEntry(status))
+}
+
+// emitSettled emits the rows the GitHub phases will not touch: those that
+// failed to load and those missing from targets (unborn or served from the
+// status cache).
+func (s *statusJSONStream) emitSettled(statuses, targets []*worktreeStatus) {
+	pending := make(map[*worktreeStatus]bool, len(targets))
+	for _, status := range targets {
+		pending[status] = true
+	}
+	for _, status := range statuses {
+		if status != nil && (status.HasError || !pending[status]) {
+			s.emit(status)
+		}
+	}
+}
+
+// finish emits every remaining row and returns the first write error.
+func (s *statusJSONStream) finish(statuses []*worktreeStatus) error {
+	for _, status := range statuses {
+		s.emit(status)
+	}
+	return s.err
+}
+
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	utc := t.UTC()
+	return &utc
+}
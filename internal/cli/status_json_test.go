@@ -0,0 +1,130 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/brandonbloom/wt/internal/processes"
+)
+
+func TestWriteStatusJSONEnvelope(t *testing.T) {
+	ts := time.Date(2024, time.March, 14, 15, 9, 26, 0, time.UTC)
+	statuses := []*worktreeStatus{{
+		Name:      "alpha",
+		Path:      "/repo/alpha",
+		Branch:    "alpha",
+		Current:   true,
+		Timestamp: ts,
+		CIState:   ciStateFailure,
+		CIStatus:  "CI✗ build",
+		CIDetail: []ciRunSummary{
+			{Name: "build", Status: "completed", Conclusion: "failure", URL: "https://example.com/runs/1", CompletedAt: ts},
+		},
+		CIRuns: []ciRunSummary{
+			{Name: "build", Status: "completed", Conclusion: "failure", URL: "https://example.com/runs/1", CompletedAt: ts},
+			{Name: "lint", Status: "completed", Conclusion: "success"},
+			{Name: "deploy", Status: "in_progress"},
+		},
+		PullRequests: []pullRequestInfo{
+			{Number: 7, State: "OPEN", URL: "https://example.com/pull/7"},
+		},
+		Processes: []processes.Process{
+			{PID: 42, Command: "npm run dev", CWD: "/repo/alpha", PPID: 1},
+		},
+	}}
+
+	var buf bytes.Buffer
+	if err := writeStatusJSON(&buf, statuses); err != nil {
+		t.Fatalf("writeStatusJSON: %v", err)
+	}
+
+	var doc map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, buf.String())
+	}
+	if doc["version"] != float64(statusJSONVersion) {
+		t.Fatalf("version = %v, want %d", doc["version"], statusJSONVersion)
+	}
+	worktrees := doc["worktrees"].([]any)
+	if len(worktrees) != 1 {
+		t.Fatalf("worktrees = %d, want 1", len(worktrees))
+	}
+	wt := worktrees[0].(map[string]any)
+	if wt["name"] != "alpha" || wt["current"] != true {
+		t.Fatalf("unexpected worktree entry: %v", wt)
+	}
+	ci := wt["ci"].(map[string]any)
+	if ci["state"] != "failure" {
+		t.Fatalf("ci.state = %v, want failure", ci["state"])
+	}
+	details := ci["details"].([]any)
+	if len(details) != 3 {
+		t.Fatalf("ci.details = %v, want every run", details)
+	}
+	run := details[0].(map[string]any)
+	if run["name"] != "build" || run["conclusion"] != "failure" || run["completed_at"] != "2024-03-14T15:09:26Z" {
+		t.Fatalf("unexpected ci run entry: %v", run)
+	}
+	if _, ok := run["started_at"]; ok {
+		t.Fatalf("zero started_at should be omitted: %v", run)
+	}
+	if lint := details[1].(map[string]any); lint["conclusion"] != "success" {
+		t.Fatalf("unexpected passing run entry: %v", lint)
+	}
+	if deploy := details[2].(map[string]any); deploy["status"] != "in_progress" {
+		t.Fatalf("unexpected pending run entry: %v", deploy)
+	}
+	procs := wt["processes"].([]any)
+	if len(procs) != 1 {
+		t.Fatalf("processes = %v", procs)
+	}
+	proc := procs[0].(map[string]any)
+	if proc["pid"] != float64(42) || proc["command"] != "npm run dev" || proc["cwd"] != "/repo/alpha" {
+		t.Fatalf("unexpected process entry: %v", proc)
+	}
+	if _, ok := proc["ppid"]; ok {
+		t.Fatalf("process entry should not expose ppid: %v", proc)
+	}
+}
+
+func TestStatusJSONStreamEmitsEachWorktreeOnce(t *testing.T) {
+	broken := &worktreeStatus{Name: "broken", HasError: true, Error: "boom"}
+	cached := &worktreeStatus{Name: "cached"}
+	fetched := &worktreeStatus{Name: "fetched"}
+	statuses := []*worktreeStatus{fetched, cached, broken}
+
+	var buf bytes.Buffer
+	stream := newStatusJSONStream(&buf)
+	// broken failed to load and cached is not a fetch target, so both are
+	// complete before the GitHub phases start.
+	stream.emitSettled(statuses, []*worktreeStatus{fetched, broken})
+	stream.emit(fetched)
+	stream.emit(fetched)
+	if err := stream.finish(statuses); err != nil {
+		t.Fatalf("finish: %v", err)
+	}
+
+	if lines := bytes.Count(buf.Bytes(), []byte("\n")); lines != 3 {
+		t.Fatalf("lines = %d, want 3:\n%s", lines, buf.String())
+	}
+	var names []string
+	dec := json.NewDecoder(&buf)
+	for dec.More() {
+		var entry statusJSONWorktree
+		if err := dec.Decode(&entry); err != nil {
+			t.Fatalf("invalid JSON line: %v", err)
+		}
+		names = append(names, entry.Name)
+	}
+	want := []string{"cached", "broken", "fetched"}
+	if len(names) != len(want) {
+		t.Fatalf("names = %v, want %v", names, want)
+	}
+	for i := range want {
+		if names[i] != want[i] {
+			t.Fatalf("names = %v, want %v", names, want)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+)
+
+// statusLegend explains the markers wt status packs into its rows. Keep it in
+// sync with formatBranchStatus, formatPRState, and applyCIResult.
+var statusLegend = [][2]string{
+	{"*", "current worktree"},
+	{"↑n ↓m", "commits ahead of / behind the upstream branch"},
+	{"[+n -m]", "commits ahead of / behind the base (origin/<default> or integration_branch)"},
+	{"dirty", "uncommitted changes (sub: only submodules changed)"},
+	{"(upstream gone)", "the tracked remote branch was deleted"},
+	{"(unpushed)", "the branch has never been pushed to origin"},
+	{"(no tree diff)", "ahead of the base, but with the same files (e.g. squashed or rebased elsewhere)"},
+	{"(would-tidy)", "wt tidy would clean this worktree up"},
+	{"(shared)", "another worktree has the same branch checked out"},
+	{"(on default branch)", "a non-default worktree has the default branch checked out"},
+	{"(locked)", "locked with wt lock; tidy and rm refuse to remove it"},
+	{"(partial)", "some git data failed to load; those columns are blank (see --json)"},
+	{"(no bootstrap)", "the bootstrap script has not succeeded here; run wt bootstrap"},
+	{"(rebasing) etc.", "a rebase, merge, cherry-pick, revert, or bisect is in progress"},
+	{"#n draft/open", "pull request number and state"},
+	{"approved", "open PR with an approving review"},
+	{"changes", "open PR with changes requested"},
+	{"⚠ conflicts", "open PR has merge conflicts with its base branch"},
+	{"CI✓ CI✗ CI◷", "CI passed, failed, or is still running"},
+	{"CI!", "CI finished with warnings"},
+	{"CI✓ (merge)", "CI ran on the open PR's merge commit, not the branch HEAD"},
+}
+
+func writeStatusLegend(w io.Writer) {
+	width := 0
+	for _, entry := range statusLegend {
+		if n := len([]rune(entry[0])); n > width {
+			width = n
+		}
+	}
+	fmt.Fprintln(w, "wt status legend:")
+	for _, entry := range statusLegend {
+		pad := width - len([]rune(entry[0]))
+		fmt.Fprintf(w, "  %s%*s  %s\n", entry[0], pad, "", entry[1])
+	}
+}
@@ -0,0 +1,8 @@
+//go:build windows
+
+package cli
+
+// Windows has no SIGWINCH; the progressive render keeps its initial width.
+func watchTerminalResize(func()) (stop func()) {
+	return func() {}
+}
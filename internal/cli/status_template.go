@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/brandonbloom/wt/internal/timefmt"
+)
+
+// parseStatusTemplate compiles a `wt status --template` format. The template
+// runs once per worktreeStatus; helper functions are bound to now so relative
+// timestamps match the table output.
+func parseStatusTemplate(text string, now time.Time) (*template.Template, error) {
+	funcs := template.FuncMap{
+		"relative": func(t time.Time) string {
+			if t.IsZero() {
+				return ""
+			}
+			return timefmt.Relative(t, now)
+		},
+		"join": strings.Join,
+	}
+	tmpl, err := template.New("status").Funcs(funcs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// executeStatusTemplate writes one line per worktree, appending a newline
+// unless the template already ends with one.
+func executeStatusTemplate(w io.Writer, tmpl *template.Template, statuses []*worktreeStatus) error {
+	var buf strings.Builder
+	for _, status := range statuses {
+		if status == nil {
+			continue
+		}
+		buf.Reset()
+		if err := tmpl.Execute(&buf, status); err != nil {
+			return fmt.Errorf("--template failed for %s: %w", status.Name, err)
+		}
+		line := buf.String()
+		if !strings.HasSuffix(line, "\n") {
+			line += "\n"
+		}
+		if _, err := io.WriteString(w, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestExecuteStatusTemplate(t *testing.T) {
+	now := time.Date(2024, time.March, 14, 15, 0, 0, 0, time.UTC)
+	tmpl, err := parseStatusTemplate(`{{.Name}} {{.Branch}} {{relative .Timestamp}}`, now)
+	if err != nil {
+		t.Fatalf("parseStatusTemplate: %v", err)
+	}
+	statuses := []*worktreeStatus{
+		{Name: "alpha", Branch: "feature/alpha", Timestamp: now.Add(-2 * time.Hour)},
+		{Name: "beta", Branch: "beta"},
+	}
+	var buf strings.Builder
+	if err := executeStatusTemplate(&buf, tmpl, statuses); err != nil {
+		t.Fatalf("executeStatusTemplate: %v", err)
+	}
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines, want 2:\n%s", len(lines), buf.String())
+	}
+	if !strings.HasPrefix(lines[0], "alpha feature/alpha ") || lines[0] == "alpha feature/alpha " {
+		t.Fatalf("line 0 = %q", lines[0])
+	}
+	if lines[1] != "beta beta " {
+		t.Fatalf("line 1 = %q", lines[1])
+	}
+}
+
+func TestStatusTemplateErrors(t *testing.T) {
+	now := time.Now()
+	if _, err := parseStatusTemplate(`{{.Name`, now); err == nil || !strings.Contains(err.Error(), "invalid --template") {
+		t.Fatalf("parse error = %v, want invalid --template", err)
+	}
+	tmpl, err := parseStatusTemplate(`{{.Nope}}`, now)
+	if err != nil {
+		t.Fatalf("parseStatusTemplate: %v", err)
+	}
+	err = executeStatusTemplate(&strings.Builder{}, tmpl, []*worktreeStatus{{Name: "alpha"}})
+	if err == nil || !strings.Contains(err.Error(), "alpha") {
+		t.Fatalf("execute error = %v, want mention of alpha", err)
+	}
+}
@@ -1,10 +1,20 @@
 package cli
 
 import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/brandonbloom/wt/internal/config"
+	"github.com/brandonbloom/wt/internal/gitutil"
 	"github.com/brandonbloom/wt/internal/processes"
+	"github.com/brandonbloom/wt/internal/project"
+	"github.com/fatih/color"
 )
 
 func TestBuildColumnLayoutUsesFullWidth(t *testing.T) {
@@ -21,13 +31,13 @@ func TestBuildColumnLayoutUsesFullWidth(t *testing.T) {
 		},
 	}}
 
-	baseLayout := buildColumnLayout(statuses, now, 0)
+	baseLayout := buildColumnLayout(statuses, now, 0, false)
 	if baseLayout.totalWidth() <= 0 {
 		t.Fatalf("expected base total width > 0, got %d", baseLayout.totalWidth())
 	}
 
 	maxWidth := baseLayout.totalWidth() + 50
-	layout := buildColumnLayout(statuses, now, maxWidth)
+	layout := buildColumnLayout(statuses, now, maxWidth, false)
 
 	if got := layout.totalWidth(); got != maxWidth {
 		t.Fatalf("layout total width = %d, want %d", got, maxWidth)
@@ -39,6 +49,90 @@ func TestBuildColumnLayoutUsesFullWidth(t *testing.T) {
 	}
 }
 
+func TestSubjectColumnFitsWithinWidth(t *testing.T) {
+	now := time.Date(2024, time.March, 14, 15, 9, 26, 0, time.UTC)
+	status := &worktreeStatus{
+		Name:      "whimsical-canoe",
+		Branch:    "whimsical-canoe",
+		Timestamp: now.Add(-30 * time.Minute),
+		PRStatus:  "No PR",
+		Subject:   "Teach the frobnicator to handle very long commit subjects gracefully",
+	}
+	statuses := []*worktreeStatus{status}
+
+	base := buildColumnLayout(statuses, now, 0, false)
+	layout := buildColumnLayout(statuses, now, 0, true)
+	if layout.subjectWidth != subjectMaxWidth {
+		t.Fatalf("subject width = %d, want %d", layout.subjectWidth, subjectMaxWidth)
+	}
+	if got, want := layout.totalWidth(), base.totalWidth()+subjectMaxWidth+columnGapWidth; got != want {
+		t.Fatalf("total width = %d, want %d", got, want)
+	}
+
+	maxWidth := 120
+	layout = buildColumnLayout(statuses, now, maxWidth, true)
+	if got := layout.totalWidth(); got != maxWidth {
+		t.Fatalf("layout total width = %d, want %d", got, maxWidth)
+	}
+	line := formatStatusLine(status, now, layout)
+	if !strings.Contains(line, "Teach the frobnicator") || !strings.Contains(line, "…") {
+		t.Fatalf("line missing truncated subject: %q", line)
+	}
+	if !strings.HasSuffix(strings.TrimRight(line, " "), "No PR") {
+		t.Fatalf("subject should precede the detail column: %q", line)
+	}
+}
+
+func TestFormatStatusLineHyperlinks(t *testing.T) {
+	now := time.Now()
+	status := &worktreeStatus{
+		Name:         "whimsical-canoe",
+		Timestamp:    now,
+		PRStatus:     "PR #7 open",
+		CIStatus:     "CI✗ lint",
+		PullRequests: []pullRequestInfo{{Number: 7, State: "OPEN", URL: "https://github.com/o/r/pull/7"}},
+		CIDetail:     []ciRunSummary{{Name: "lint", URL: "https://github.com/o/r/actions/runs/1"}},
+	}
+	layout := buildColumnLayout([]*worktreeStatus{status}, now, 0, false)
+	plain := formatStatusLine(status, now, layout)
+	if strings.Contains(plain, "\x1b]8") {
+		t.Fatalf("hyperlinks emitted without opt-in: %q", plain)
+	}
+
+	layout.hyperlinks = true
+	line := formatStatusLine(status, now, layout)
+	for _, want := range []string{
+		"\x1b]8;;https://github.com/o/r/pull/7\x1b\\PR #7 open\x1b]8;;\x1b\\",
+		"\x1b]8;;https://github.com/o/r/actions/runs/1\x1b\\CI✗ lint\x1b]8;;\x1b\\",
+	} {
+		if !strings.Contains(line, want) {
+			t.Fatalf("line missing %q: %q", want, line)
+		}
+	}
+}
+
+func TestHyperlinksSupported(t *testing.T) {
+	cases := []struct {
+		env  map[string]string
+		want bool
+	}{
+		{nil, false},
+		{map[string]string{"TERM_PROGRAM": "iTerm.app"}, true},
+		{map[string]string{"VTE_VERSION": "4600"}, false},
+		{map[string]string{"VTE_VERSION": "6003"}, true},
+		{map[string]string{"FORCE_HYPERLINK": "1"}, true},
+		{map[string]string{"TERM_PROGRAM": "WezTerm", "FORCE_HYPERLINK": "0"}, false},
+		{map[string]string{"WT_HYPERLINKS": "false", "FORCE_HYPERLINK": "1"}, false},
+		{map[string]string{"WT_HYPERLINKS": "1"}, true},
+	}
+	for _, tc := range cases {
+		got := hyperlinksSupported(func(key string) string { return tc.env[key] })
+		if got != tc.want {
+			t.Errorf("hyperlinksSupported(%v) = %t, want %t", tc.env, got, tc.want)
+		}
+	}
+}
+
 func TestStatusFieldsCombinesInterrupted(t *testing.T) {
 	now := time.Now()
 	status := &worktreeStatus{
@@ -66,3 +160,373 @@ func TestCombineStatusDetailOmitsCINoiseForNoPR(t *testing.T) {
 		t.Fatalf("combineStatusDetail = %q, want %q", got, want)
 	}
 }
+
+func TestMarkSharedBranchesFlagsDuplicates(t *testing.T) {
+	statuses := []*worktreeStatus{
+		{Name: "main", Branch: "main"},
+		{Name: "alpha", Branch: "feature"},
+		{Name: "beta", Branch: "feature"},
+		{Name: "detached", Branch: "HEAD"},
+		{Name: "other-detached", Branch: "HEAD"},
+	}
+	markSharedBranches(statuses)
+
+	want := map[string]bool{"main": false, "alpha": true, "beta": true, "detached": false, "other-detached": false}
+	for _, status := range statuses {
+		if status.SharedBranch != want[status.Name] {
+			t.Fatalf("%s SharedBranch = %t, want %t", status.Name, status.SharedBranch, want[status.Name])
+		}
+	}
+	if got := formatBranchStatus(statuses[1], false, true); got != "feature (shared)" {
+		t.Fatalf("formatBranchStatus = %q, want %q", got, "feature (shared)")
+	}
+}
+
+func TestMarkSharedBranchesHighlightsCurrentBranch(t *testing.T) {
+	statuses := []*worktreeStatus{
+		{Name: "main", Branch: "main"},
+		{Name: "alpha", Branch: "feature", Current: true},
+		{Name: "beta", Branch: "feature"},
+		{Name: "gamma", Branch: "other"},
+		{Name: "delta", Branch: "other"},
+	}
+	markSharedBranches(statuses)
+
+	want := map[string]bool{"main": false, "alpha": true, "beta": true, "gamma": false, "delta": false}
+	for _, status := range statuses {
+		if status.SharesCurrentBranch != want[status.Name] {
+			t.Fatalf("%s SharesCurrentBranch = %t, want %t", status.Name, status.SharesCurrentBranch, want[status.Name])
+		}
+	}
+	if got := formatBranchStatus(statuses[2], false, true); got != "feature (shared with current)" {
+		t.Fatalf("formatBranchStatus = %q, want %q", got, "feature (shared with current)")
+	}
+	if got := formatBranchStatus(statuses[3], false, true); got != "other (shared)" {
+		t.Fatalf("formatBranchStatus = %q, want %q", got, "other (shared)")
+	}
+}
+
+func TestSubmoduleOnlyChangesShowSubMarker(t *testing.T) {
+	sub := initTempRepo(t)
+	repo := initTempRepo(t)
+	gitCmd(t, repo, "-c", "protocol.file.allow=always", "submodule", "add", sub, "vendor/sub")
+	gitCmd(t, repo, "commit", "-m", "add submodule")
+	writeFile(t, filepath.Join(repo, "vendor", "sub", "README.md"), "changed")
+
+	gitCmd(t, repo, "config", "diff.ignoreSubmodules", "all")
+	if status, err := gitutil.Status(repo); err != nil {
+		t.Fatalf("Status: %v", err)
+	} else if status.HasChanges {
+		t.Fatalf("expected ignored submodule to look clean, got %+v", status)
+	}
+
+	status, err := gitutil.StatusIncludingSubmodules(repo)
+	if err != nil {
+		t.Fatalf("StatusIncludingSubmodules: %v", err)
+	}
+	if !status.HasChanges || !status.SubmodulesOnly {
+		t.Fatalf("expected submodule-only changes, got %+v", status)
+	}
+	row := &worktreeStatus{Name: "repo", Branch: "repo", Dirty: true, SubmodulesOnly: true}
+	if got := formatBranchStatus(row, false, false); got != "sub" {
+		t.Fatalf("formatBranchStatus = %q, want %q", got, "sub")
+	}
+
+	writeFile(t, filepath.Join(repo, "README.md"), "changed too")
+	status, err = gitutil.StatusIncludingSubmodules(repo)
+	if err != nil {
+		t.Fatalf("StatusIncludingSubmodules: %v", err)
+	}
+	if status.SubmodulesOnly {
+		t.Fatalf("expected file changes to clear SubmodulesOnly, got %+v", status)
+	}
+}
+
+func TestUpstreamGoneIsDistinguishedFromNoUpstream(t *testing.T) {
+	repo := initTempRepo(t)
+	status, err := gitutil.Status(repo)
+	if err != nil {
+		t.Fatalf("Status: %v", err)
+	}
+	if status.UpstreamGone {
+		t.Fatalf("branch without upstream reported gone: %+v", status)
+	}
+
+	gitCmd(t, repo, "remote", "add", "origin", "https://example.com/repo.git")
+	gitCmd(t, repo, "config", "branch."+status.Head+".remote", "origin")
+	gitCmd(t, repo, "config", "branch."+status.Head+".merge", "refs/heads/deleted")
+	status, err = gitutil.Status(repo)
+	if err != nil {
+		t.Fatalf("Status: %v", err)
+	}
+	if !status.UpstreamGone || status.Upstream != "origin/deleted" {
+		t.Fatalf("expected gone upstream origin/deleted, got %+v", status)
+	}
+
+	row := &worktreeStatus{Name: "feature", Branch: "feature", UpstreamGone: true}
+	if got, want := formatBranchStatus(row, false, true), "(upstream gone)"; got != want {
+		t.Fatalf("formatBranchStatus = %q, want %q", got, want)
+	}
+}
+
+func TestUnbornWorktreeShowsNoCommits(t *testing.T) {
+	dir := t.TempDir()
+	gitCmd(t, dir, "init", "--quiet", "--initial-branch=main")
+	writeFile(t, filepath.Join(dir, "notes.txt"), "draft")
+
+	proj := &project.Project{Root: filepath.Dir(dir), Config: config.Default("main")}
+	wt := project.Worktree{Name: "fresh", Path: dir}
+	status, err := collectWorktreeStatus(context.Background(), proj, wt, "", gatherWorktreeGitDataOptionsStatus)
+	if err != nil {
+		t.Fatalf("collectWorktreeStatus: %v", err)
+	}
+	if !status.Unborn || status.Branch != "main" || !status.Dirty || !status.Timestamp.IsZero() {
+		t.Fatalf("unexpected status: %+v", status)
+	}
+	if got := remoteStatusTargets([]*worktreeStatus{status}); len(got) != 0 {
+		t.Fatalf("unborn worktree should not be fetched, got %d targets", len(got))
+	}
+}
+
+func TestPartialGitFailureKeepsRow(t *testing.T) {
+	proj := initTempProject(t, "default_branch = \"main\"\n")
+	dir := proj.DefaultWorktreePath
+	writeFile(t, filepath.Join(dir, "README.md"), "edited")
+
+	wt := project.Worktree{Name: "odd", Path: dir}
+	opts := gatherWorktreeGitDataOptionsStatus
+	opts.BaseRef = "origin/missing"
+	if _, err := collectWorktreeStatus(context.Background(), proj, wt, "", opts); err == nil {
+		t.Fatalf("expected a hard failure without AllowPartial")
+	}
+
+	opts.AllowPartial = true
+	status, err := collectWorktreeStatus(context.Background(), proj, wt, "", opts)
+	if err != nil {
+		t.Fatalf("collectWorktreeStatus: %v", err)
+	}
+	if status.Branch != "main" || !status.Dirty || status.HeadHash == "" {
+		t.Fatalf("expected branch and dirty state despite the failure: %+v", status)
+	}
+	if len(status.PartialErrors) != 1 || status.PartialErrors[0].Field != "ahead/behind base" {
+		t.Fatalf("PartialErrors = %+v", status.PartialErrors)
+	}
+	if got, want := formatBranchStatus(status, true, true), "main (partial) dirty"; got != want {
+		t.Fatalf("formatBranchStatus = %q, want %q", got, want)
+	}
+}
+
+func TestMarkDefaultBranchCheckoutsFlagsStrayWorktrees(t *testing.T) {
+	statuses := []*worktreeStatus{
+		{Name: "main", Branch: "main"},
+		{Name: "scratch", Branch: "main"},
+		{Name: "feature", Branch: "feature"},
+	}
+	markDefaultBranchCheckouts(statuses, "main", "main")
+	if statuses[0].OnDefaultBranch || !statuses[1].OnDefaultBranch || statuses[2].OnDefaultBranch {
+		t.Fatalf("unexpected flags: %v %v %v", statuses[0].OnDefaultBranch, statuses[1].OnDefaultBranch, statuses[2].OnDefaultBranch)
+	}
+	if got, want := formatBranchStatus(statuses[1], false, false), "main (on default branch)"; got != want {
+		t.Fatalf("formatBranchStatus = %q, want %q", got, want)
+	}
+}
+
+func TestLockedWorktreeIsAnnotated(t *testing.T) {
+	row := &worktreeStatus{Name: "usb", Branch: "feature", Locked: true}
+	if got, want := formatBranchStatus(row, false, false), "feature (locked)"; got != want {
+		t.Fatalf("formatBranchStatus = %q, want %q", got, want)
+	}
+}
+
+func TestPrintCIFailuresListsOnlyFailures(t *testing.T) {
+	statuses := []*worktreeStatus{
+		{Name: "green", CIState: ciStateSuccess},
+		{Name: "red", CIState: ciStateFailure, CIDetail: []ciRunSummary{{Name: "build", URL: "https://example.com/runs/1"}}},
+		{Name: "pending", CIState: ciStatePending},
+	}
+	var buf bytes.Buffer
+	if got := printCIFailures(&buf, statuses); got != 1 {
+		t.Fatalf("printCIFailures = %d, want 1", got)
+	}
+	want := "red: build https://example.com/runs/1\n"
+	if buf.String() != want {
+		t.Fatalf("output = %q, want %q", buf.String(), want)
+	}
+
+	buf.Reset()
+	if got := printCIFailures(&buf, statuses[:1]); got != 0 || buf.Len() != 0 {
+		t.Fatalf("expected no output for green worktrees, got %d %q", got, buf.String())
+	}
+}
+
+func TestStatusFieldsAbsoluteTime(t *testing.T) {
+	now := time.Date(2025, time.December, 5, 15, 0, 0, 0, time.UTC)
+	statuses := []*worktreeStatus{{Name: "alpha", Branch: "alpha", Timestamp: now.Add(-50 * time.Hour)}}
+
+	applyStatusDisplay(statuses, "/repo", &statusOptions{timeFormat: timeFormatAbsolute})
+	if got := statusFields(statuses[0], now, false, 0)[1]; got != "2025-12-03 13:00" {
+		t.Fatalf("absolute time field = %q", got)
+	}
+	applyStatusDisplay(statuses, "/repo", &statusOptions{})
+	if got := statusFields(statuses[0], now, false, 0)[1]; got != "2 days ago" {
+		t.Fatalf("relative time field = %q", got)
+	}
+}
+
+func TestStatusFieldsShowPath(t *testing.T) {
+	now := time.Now()
+	statuses := []*worktreeStatus{{Name: "alpha", Branch: "alpha", Path: "/repo/alpha", Timestamp: now}}
+
+	applyStatusDisplay(statuses, "/repo", &statusOptions{showPath: showPathRelative})
+	if got := statusFields(statuses[0], now, false, 0)[0]; got != "  alpha  (alpha)" {
+		t.Fatalf("relative name field = %q", got)
+	}
+
+	applyStatusDisplay(statuses, "/repo", &statusOptions{showPath: showPathAbsolute})
+	if got := statusFields(statuses[0], now, false, 0)[0]; got != "  alpha  (/repo/alpha)" {
+		t.Fatalf("absolute name field = %q", got)
+	}
+
+	applyStatusDisplay(statuses, "/repo", &statusOptions{})
+	if got := statusFields(statuses[0], now, false, 0)[0]; got != "  alpha" {
+		t.Fatalf("default name field = %q", got)
+	}
+}
+
+func TestFormatBranchStatusDeltaToggles(t *testing.T) {
+	status := &worktreeStatus{Name: "alpha", Branch: "alpha", Ahead: 2, Behind: 1, BaseAhead: 3, BaseBehind: 4}
+	cases := []struct {
+		opts statusOptions
+		want string
+	}{
+		{statusOptions{}, "↑2 ↓1 [+3 -4]"},
+		{statusOptions{remoteOnly: true}, "↑2 ↓1"},
+		{statusOptions{baseOnly: true}, "[+3 -4]"},
+	}
+	for _, tc := range cases {
+		applyStatusDisplay([]*worktreeStatus{status}, "/repo", &tc.opts)
+		if got := statusFields(status, time.Now(), false, 0)[0]; got != "  alpha  "+tc.want {
+			t.Fatalf("opts %+v: name field = %q, want %q", tc.opts, got, "  alpha  "+tc.want)
+		}
+	}
+}
+
+func TestChooseTimeColorThresholds(t *testing.T) {
+	now := time.Date(2024, time.March, 14, 12, 0, 0, 0, time.UTC)
+	threshold := 7 * 24 * time.Hour
+	prev := color.NoColor
+	color.NoColor = false
+	t.Cleanup(func() { color.NoColor = prev })
+	const (
+		gray   = "\x1b[90mx\x1b[0m"
+		yellow = "\x1b[33mx\x1b[0m"
+		red    = "\x1b[31mx\x1b[0m"
+	)
+	cases := []struct {
+		age  time.Duration
+		want string
+	}{
+		{24 * time.Hour, gray},
+		{8 * 24 * time.Hour, yellow},
+		{15 * 24 * time.Hour, red},
+	}
+	for _, tc := range cases {
+		if got := chooseTimeColor(now.Add(-tc.age), now, threshold)("x"); got != tc.want {
+			t.Fatalf("age %s: rendered %q, want %q", tc.age, got, tc.want)
+		}
+	}
+	if got := chooseTimeColor(now.Add(-100*24*time.Hour), now, 0)("x"); got != gray {
+		t.Fatalf("--age unset: rendered %q, want the default %q", got, gray)
+	}
+}
+
+func TestWorktreeOperationDetectsSequencerStates(t *testing.T) {
+	repo := initTempRepo(t)
+	cases := map[string]string{
+		"CHERRY_PICK_HEAD": "cherry-picking",
+		"REVERT_HEAD":      "reverting",
+		"BISECT_LOG":       "bisecting",
+	}
+	for marker, want := range cases {
+		path := filepath.Join(repo, ".git", marker)
+		writeFile(t, path, "")
+		got, err := gitutil.WorktreeOperation(repo)
+		if err != nil {
+			t.Fatalf("WorktreeOperation: %v", err)
+		}
+		if got != want {
+			t.Fatalf("%s: operation = %q, want %q", marker, got, want)
+		}
+		if err := os.Remove(path); err != nil {
+			t.Fatalf("remove %s: %v", marker, err)
+		}
+	}
+}
+
+func TestPrintOperationsInProgress(t *testing.T) {
+	statuses := []*worktreeStatus{
+		{Name: "alpha", Operation: "rebasing"},
+		{Name: "beta"},
+		{Name: "gamma", Operation: "bisecting"},
+	}
+	var buf bytes.Buffer
+	if got := printOperationsInProgress(&buf, statuses); got != 2 {
+		t.Fatalf("printOperationsInProgress = %d, want 2", got)
+	}
+	want := "\nIn progress: alpha (rebasing), gamma (bisecting)\n"
+	if buf.String() != want {
+		t.Fatalf("output = %q, want %q", buf.String(), want)
+	}
+}
+
+func TestStatusRendererClearsScreenAfterResize(t *testing.T) {
+	f, err := os.CreateTemp(t.TempDir(), "render")
+	if err != nil {
+		t.Fatalf("CreateTemp: %v", err)
+	}
+	defer f.Close()
+	r := &statusRenderer{w: f}
+	statuses := []*worktreeStatus{{Name: "main", Branch: "main"}}
+	now := time.Now()
+	layout := buildColumnLayout(statuses, now, 80, false)
+
+	r.Render(statuses, layout, now)
+	if r.takeResize() {
+		t.Fatalf("takeResize reported a resize that never happened")
+	}
+	r.markResized()
+	if !r.takeResize() || r.takeResize() {
+		t.Fatalf("takeResize should report a resize exactly once")
+	}
+	r.Render(statuses, layout, now)
+
+	data, err := os.ReadFile(f.Name())
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	if !strings.Contains(string(data), "\x1b[H\x1b[2J") {
+		t.Fatalf("expected a full clear after resize, got %q", data)
+	}
+}
+
+func TestMarkTidyThresholdsPreviewsTidy(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	statuses := []*worktreeStatus{
+		{Name: "old", Branch: "old", UniqueAhead: 1, Timestamp: now.AddDate(0, 0, -30)},
+		{Name: "drifted", Branch: "drifted", UniqueAhead: 1, BaseAhead: 3, BaseBehind: 25, Timestamp: now},
+		{Name: "merged", Branch: "merged", Timestamp: now.AddDate(0, 0, -30)},
+	}
+	markTidyThresholds(statuses, tidyThresholds{DivergenceCommits: 20, StaleDays: 14}, now, "main")
+	if got, want := statuses[0].TidyReasons, []string{"stale for 30 days"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("old reasons = %v, want %v", got, want)
+	}
+	if got, want := statuses[1].TidyReasons, []string{"diverged +3/-25 from main"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("drifted reasons = %v, want %v", got, want)
+	}
+	if statuses[2].TidyReasons != nil {
+		t.Fatalf("branch without unique commits flagged: %v", statuses[2].TidyReasons)
+	}
+	if got, want := formatBranchStatus(statuses[0], false, false), "(would-tidy)"; got != want {
+		t.Fatalf("formatBranchStatus = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,157 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+
+	"github.com/brandonbloom/wt/internal/gitutil"
+	"github.com/brandonbloom/wt/internal/project"
+	"github.com/spf13/cobra"
+)
+
+func newSweepCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "sweep",
+		Short: "Fetch and prune origin, then report worktrees it left behind",
+		Args:  cobra.NoArgs,
+		RunE:  runSweep,
+	}
+}
+
+// sweepGone is a worktree whose branch's upstream no longer exists.
+type sweepGone struct {
+	Worktree project.Worktree
+	Upstream string
+	// JustPruned is set when this sweep's fetch deleted the upstream, as
+	// opposed to one that was already gone.
+	JustPruned bool
+}
+
+// sweepBehind is a worktree whose HEAD lacks commits from the merge target.
+type sweepBehind struct {
+	Worktree project.Worktree
+	Ahead    int
+	Behind   int
+}
+
+func runSweep(cmd *cobra.Command, args []string) error {
+	proj, err := loadProjectFromWD()
+	if err != nil {
+		return err
+	}
+	dir := proj.DefaultWorktreePath
+	if dir == "" {
+		return errors.New("default worktree not found; wt sweep fetches from it")
+	}
+	if _, err := gitutil.RemoteURL(dir, "origin"); err != nil {
+		return errors.New("no origin remote to fetch from")
+	}
+	target := proj.Config.MergeTarget()
+	targetRef := "origin/" + target
+
+	before, err := gitutil.BranchUpstreams(dir)
+	if err != nil {
+		return err
+	}
+	oldTarget := resolveCommit(dir, targetRef)
+
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+	defer stop()
+	if err := gitutil.FetchRemotePrune(ctx, dir, "origin"); err != nil {
+		return err
+	}
+
+	after, err := gitutil.BranchUpstreams(dir)
+	if err != nil {
+		return err
+	}
+	newTarget := resolveCommit(dir, targetRef)
+
+	worktrees, err := project.ListWorktrees(proj.Root)
+	if err != nil {
+		return err
+	}
+	var gone []sweepGone
+	var behind []sweepBehind
+	for _, wt := range worktrees {
+		if wt.Name == proj.DefaultWorktree || wt.Branch == "" {
+			continue
+		}
+		if up, ok := after[wt.Branch]; ok && up.Gone {
+			gone = append(gone, sweepGone{
+				Worktree:   wt,
+				Upstream:   strings.TrimPrefix(up.Ref, "refs/remotes/"),
+				JustPruned: !before[wt.Branch].Gone,
+			})
+			continue
+		}
+		if newTarget == "" || wt.Branch == target {
+			continue
+		}
+		ahead, behindBy, err := gitutil.AheadBehindRef(wt.Path, targetRef)
+		if err != nil {
+			return fmt.Errorf("%s: %w", wt.Name, err)
+		}
+		if behindBy > 0 {
+			behind = append(behind, sweepBehind{Worktree: wt, Ahead: ahead, Behind: behindBy})
+		}
+	}
+
+	out := cmd.OutOrStdout()
+	printSweepTarget(out, dir, targetRef, oldTarget, newTarget)
+	if len(gone) > 0 {
+		fmt.Fprintln(out, "Upstream gone:")
+		for _, g := range gone {
+			note := ""
+			if g.JustPruned {
+				note = " (just pruned)"
+			}
+			fmt.Fprintf(out, "  %s  %s%s\n", g.Worktree.Name, g.Upstream, note)
+		}
+	}
+	if len(behind) > 0 {
+		fmt.Fprintf(out, "Behind %s:\n", targetRef)
+		for _, b := range behind {
+			fmt.Fprintf(out, "  %s  %s\n", b.Worktree.Name, formatBaseDelta(b.Ahead, b.Behind))
+		}
+	}
+	if len(gone) == 0 && len(behind) == 0 {
+		fmt.Fprintln(out, "Nothing to reconcile")
+	}
+	return nil
+}
+
+// printSweepTarget reports how far the fetch moved the merge target.
+func printSweepTarget(out io.Writer, dir, ref, oldHash, newHash string) {
+	switch {
+	case newHash == "":
+		fmt.Fprintf(out, "Fetched origin; %s not found\n", ref)
+	case oldHash == newHash:
+		fmt.Fprintf(out, "Fetched origin; %s unchanged\n", ref)
+	case oldHash == "":
+		fmt.Fprintf(out, "Fetched origin; %s fetched for the first time\n", ref)
+	default:
+		// A force-push can move the ref without adding anything on top.
+		count, err := gitutil.Run(dir, "rev-list", "--count", oldHash+".."+newHash)
+		n, convErr := strconv.Atoi(count)
+		if err != nil || convErr != nil || n == 0 {
+			fmt.Fprintf(out, "Fetched origin; %s moved\n", ref)
+			return
+		}
+		fmt.Fprintf(out, "Fetched origin; %s advanced %d %s\n", ref, n, pluralize(n, "commit", "commits"))
+	}
+}
+
+// resolveCommit returns ref's commit hash, or "" when it does not exist.
+func resolveCommit(dir, ref string) string {
+	hash, err := gitutil.Run(dir, "rev-parse", "--verify", "--quiet", ref+"^{commit}")
+	if err != nil {
+		return ""
+	}
+	return hash
+}
@@ -0,0 +1,164 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/brandonbloom/wt/internal/gitutil"
+	"github.com/brandonbloom/wt/internal/project"
+	"github.com/spf13/cobra"
+)
+
+type syncOptions struct {
+	all   bool
+	merge bool
+}
+
+func newSyncCommand() *cobra.Command {
+	opts := &syncOptions{}
+	cmd := &cobra.Command{
+		Use:   "sync [<worktrees...>]",
+		Short: "Rebase worktrees onto the latest default branch",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSync(cmd, opts, args)
+		},
+	}
+	cmd.Flags().BoolVarP(&opts.all, "all", "a", false, "sync every non-default worktree")
+	cmd.Flags().BoolVar(&opts.merge, "merge", false, "merge the default branch instead of rebasing")
+	return cmd
+}
+
+func runSync(cmd *cobra.Command, opts *syncOptions, args []string) error {
+	if opts.all && len(args) > 0 {
+		return errors.New("cannot combine --all with explicit worktrees")
+	}
+
+	proj, err := loadProjectFromWD()
+	if err != nil {
+		return err
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	worktrees, err := project.ListWorktrees(proj.Root)
+	if err != nil {
+		return err
+	}
+
+	targets, err := resolveSyncTargets(proj, worktrees, args, wd, opts.all)
+	if err != nil {
+		return err
+	}
+	if len(targets) == 0 {
+		fmt.Fprintln(cmd.OutOrStdout(), "No worktrees to sync.")
+		return nil
+	}
+
+	remote := "origin"
+	defaultBranch := proj.Config.DefaultBranch
+	if err := gitutil.FetchRemoteDefaultBranch(cmd.Context(), proj.DefaultWorktreePath, remote, defaultBranch); err != nil {
+		return err
+	}
+	upstream := remote + "/" + defaultBranch
+
+	out := cmd.OutOrStdout()
+	var combined error
+	for i, target := range targets {
+		fmt.Fprintf(out, "%s:\n", target.Name)
+		if err := syncWorktree(out, target, upstream, opts.merge); err != nil {
+			fmt.Fprintf(out, "  error: %s\n", singleLineError(err))
+			combined = errors.Join(combined, fmt.Errorf("%s: %w", target.Name, err))
+		}
+		if i < len(targets)-1 {
+			fmt.Fprintln(out)
+		}
+	}
+	return combined
+}
+
+func resolveSyncTargets(proj *project.Project, worktrees []project.Worktree, args []string, wd string, all bool) ([]project.Worktree, error) {
+	if all {
+		targets := make([]project.Worktree, 0, len(worktrees))
+		for _, wt := range worktrees {
+			if wt.Name != proj.DefaultWorktree {
+				targets = append(targets, wt)
+			}
+		}
+		return targets, nil
+	}
+	if len(args) == 0 {
+		current := findWorktreeContaining(worktrees, wd)
+		if current == nil {
+			return nil, errors.New("not inside a worktree; pass worktree names or --all")
+		}
+		args = []string{current.Name}
+	}
+	targets, err := resolveWorktreeArgs(worktrees, args, targetBaseDir(proj, wd))
+	if err != nil {
+		return nil, err
+	}
+	for _, target := range targets {
+		if target.Name == proj.DefaultWorktree {
+			return nil, fmt.Errorf("%s is the default worktree; sync updates feature worktrees onto it", target.Name)
+		}
+	}
+	return targets, nil
+}
+
+// syncWorktree rebases (or merges) a single worktree onto upstream. Dirty,
+// detached, or mid-operation worktrees are skipped rather than treated as
+// failures; conflicts are left in place for the user to resolve.
+func syncWorktree(out io.Writer, target project.Worktree, upstream string, merge bool) error {
+	status, err := gitutil.Status(target.Path)
+	if err != nil {
+		return err
+	}
+	if status.HasChanges {
+		fmt.Fprintln(out, "  warning: skipped; worktree has uncommitted changes")
+		return nil
+	}
+	if status.Head == "" || status.Head == "HEAD" {
+		fmt.Fprintln(out, "  warning: skipped; HEAD is detached")
+		return nil
+	}
+	if op, err := gitutil.WorktreeOperation(target.Path); err != nil {
+		return err
+	} else if op != "" {
+		fmt.Fprintf(out, "  warning: skipped; %s already in progress\n", op)
+		return nil
+	}
+
+	verb, args := "rebased onto", []string{"rebase", upstream}
+	abort := "git rebase --abort"
+	if merge {
+		verb, args = "merged", []string{"merge", "--no-edit", upstream}
+		abort = "git merge --abort"
+	}
+	output, err := runGitCapture(target.Path, nil, args...)
+	if err != nil {
+		if op, _ := gitutil.WorktreeOperation(target.Path); op != "" {
+			fmt.Fprintf(out, "  conflicts; resolve in %s or run `%s`\n", target.Path, abort)
+			return fmt.Errorf("%s stopped with conflicts", args[0])
+		}
+		msg := strings.TrimSpace(output)
+		if msg == "" {
+			msg = err.Error()
+		}
+		return fmt.Errorf("git %s failed: %s", args[0], msg)
+	}
+
+	after, err := gitutil.Run(target.Path, "rev-parse", "HEAD")
+	if err != nil {
+		return err
+	}
+	if after == status.HeadOID {
+		fmt.Fprintf(out, "  already up to date with %s\n", upstream)
+		return nil
+	}
+	fmt.Fprintf(out, "  %s %s\n", verb, upstream)
+	return nil
+}
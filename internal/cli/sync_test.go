@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/brandonbloom/wt/internal/project"
+)
+
+func TestSyncWorktreeRebasesAndSkipsDirty(t *testing.T) {
+	repo := initTempRepo(t)
+	worktreePath := filepath.Join(repo, "feature")
+	gitCmd(t, repo, "worktree", "add", "-b", "feature", worktreePath)
+	target := project.Worktree{Name: "feature", Path: worktreePath}
+
+	writeFile(t, filepath.Join(worktreePath, "feature.txt"), "feature")
+	gitCmd(t, worktreePath, "add", "feature.txt")
+	gitCmd(t, worktreePath, "commit", "-m", "feature")
+
+	writeFile(t, filepath.Join(repo, "base.txt"), "base")
+	gitCmd(t, repo, "add", "base.txt")
+	gitCmd(t, repo, "commit", "-m", "base")
+	gitCmd(t, repo, "branch", "upstream-tip")
+
+	writeFile(t, filepath.Join(worktreePath, "scratch.txt"), "dirty")
+	var out bytes.Buffer
+	if err := syncWorktree(&out, target, "upstream-tip", false); err != nil {
+		t.Fatalf("syncWorktree dirty: %v", err)
+	}
+	if !strings.Contains(out.String(), "uncommitted changes") {
+		t.Fatalf("expected dirty skip warning, got %q", out.String())
+	}
+
+	gitCmd(t, worktreePath, "clean", "-fd")
+	out.Reset()
+	if err := syncWorktree(&out, target, "upstream-tip", false); err != nil {
+		t.Fatalf("syncWorktree: %v\n%s", err, out.String())
+	}
+	if !strings.Contains(out.String(), "rebased onto upstream-tip") {
+		t.Fatalf("unexpected output %q", out.String())
+	}
+
+	out.Reset()
+	if err := syncWorktree(&out, target, "upstream-tip", false); err != nil {
+		t.Fatalf("syncWorktree second pass: %v", err)
+	}
+	if !strings.Contains(out.String(), "already up to date") {
+		t.Fatalf("expected up-to-date message, got %q", out.String())
+	}
+}
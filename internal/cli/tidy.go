@@ -33,6 +33,16 @@ const (
 	tidyPolicyPrompt tidyPolicy = "prompt"
 )
 
+// tidySortOrder orders tidy's dashboard and the cleanup queue alike.
+type tidySortOrder string
+
+const (
+	tidySortNewest     tidySortOrder = "newest"
+	tidySortOldest     tidySortOrder = "oldest"
+	tidySortName       tidySortOrder = "name"
+	tidySortDivergence tidySortOrder = "divergence"
+)
+
 type tidyStage string
 
 const (
@@ -46,13 +56,23 @@ const (
 	tidyStageError    tidyStage = "error"
 )
 
-const blockReasonCurrentWorktree = "currently inside this worktree"
+const (
+	blockReasonCurrentWorktree = "currently inside this worktree"
+	blockReasonSinceWindow     = "active within --since window"
+	// blockReasonMainWorkingTree guards the repository's original checkout,
+	// which only appears as a candidate when the default worktree is linked.
+	blockReasonMainWorkingTree = "repository's main working tree"
+)
 
 const tidyPromptLogLimit = 10
 
 type tidyDeriveContext struct {
 	Now      time.Time
 	Workflow workflowExpectations
+	// NoPRIsSafe lets a branch that never had a PR count as safe when it is
+	// merged into the default branch by ancestry or tree, even with unique
+	// commits (e.g. squashed locally).
+	NoPRIsSafe bool
 }
 
 type tidyOptions struct {
@@ -63,6 +83,23 @@ type tidyOptions struct {
 	promptAlias bool
 	killFlag    string
 	timeoutFlag string
+	escalate    bool
+	sinceFlag   string
+	parallel    int
+	verbose     bool
+	includeNoPR bool
+	// againstDefault ignores integration_branch and compares to default_branch.
+	againstDefault bool
+	// yes answers gray prompts affirmatively without changing the policy.
+	yes bool
+	// reportPath appends a JSON lines record of each cleanup action.
+	reportPath string
+	// onlyMergedRemote requires HEAD to be an ancestor of origin/<default>
+	// before a worktree counts as safe.
+	onlyMergedRemote bool
+	sortFlag         string
+	// noRemotePrune skips `git remote prune origin` after remote deletions.
+	noRemotePrune bool
 }
 
 func newTidyCommand() *cobra.Command {
@@ -75,6 +112,7 @@ func newTidyCommand() *cobra.Command {
 		},
 	}
 	cmd.Flags().BoolVarP(&opts.dryRun, "dry-run", "n", false, "show actions without deleting anything")
+	cmd.Flags().BoolVarP(&opts.yes, "yes", "y", false, "answer yes to cleanup prompts; blocked worktrees are still skipped")
 	cmd.Flags().StringVar(&opts.policyFlag, "policy", "", "tidy policy: auto (default), safe, all, or prompt")
 	cmd.Flags().BoolVarP(&opts.safeAlias, "safe", "s", false, "alias for --policy safe")
 	cmd.Flags().BoolVarP(&opts.allAlias, "all", "a", false, "alias for --policy all")
@@ -84,23 +122,35 @@ func newTidyCommand() *cobra.Command {
 		flag.NoOptDefVal = "true"
 	}
 	cmd.Flags().StringVar(&opts.timeoutFlag, "timeout", "", "time to wait for --kill to succeed (e.g. 3s)")
+	cmd.Flags().BoolVar(&opts.escalate, "escalate", false, "with --kill, re-signal survivors with process.escalate_signal (default KILL) after the timeout")
+	cmd.Flags().StringVar(&opts.sinceFlag, "since", "", "only consider worktrees idle for at least this long (e.g. 7d, 48h)")
+	cmd.Flags().IntVar(&opts.parallel, "parallel", 1, "remove up to N worktrees concurrently when no prompts are needed")
+	cmd.Flags().BoolVarP(&opts.verbose, "verbose", "v", false, "with --dry-run, show the git facts behind each classification")
+	cmd.Flags().BoolVar(&opts.onlyMergedRemote, "only-merged-remote", false, "only treat worktrees as safe when HEAD is merged into origin/<default>")
+	cmd.Flags().StringVar(&opts.reportPath, "report", "", "append a JSON lines log of every cleanup action to this file")
+	cmd.Flags().BoolVar(&opts.againstDefault, "against-default", false, "judge merges against default_branch even when integration_branch is set")
+	cmd.Flags().BoolVar(&opts.noRemotePrune, "no-remote-prune", false, "skip `git remote prune origin` after deleting remote branches")
+	cmd.Flags().BoolVar(&opts.includeNoPR, "include-no-pr", false, "treat merged branches that never had a PR as safe even with unique commits")
+	cmd.Flags().StringVar(&opts.sortFlag, "sort", string(tidySortNewest), "order to show and process worktrees: newest, oldest, name, or divergence")
 	return cmd
 }
 
 func runTidy(cmd *cobra.Command, opts *tidyOptions) error {
-	if _, err := exec.LookPath("gh"); err != nil {
-		return fmt.Errorf("gh CLI required: %w", err)
-	}
-
 	proj, err := loadProjectFromWD()
 	if err != nil {
 		return err
 	}
-	compareCtx := defaultBranchComparisonContext(proj)
+	ciRepo, ciRepoErr := resolveForgeRepo(proj)
+	if !ghDisabled(proj) && ciRepo.usesGh() {
+		if _, err := exec.LookPath("gh"); err != nil {
+			return fmt.Errorf("gh CLI required: %w", err)
+		}
+	}
+	ghAuth := startGhAuthCheck(cmd.Context(), proj, ciRepo)
+	compareCtx := mergeTargetComparisonContext(proj, opts.againstDefault)
 	workflow := workflowExpectationsForProject(compareCtx)
-	ciRepo, ciRepoErr := resolveGitHubRepo(proj)
 
-	if compareCtx.SyncMode == gitutil.DefaultBranchRemoteFirst {
+	if compareCtx.SyncMode == gitutil.DefaultBranchRemoteFirst || opts.onlyMergedRemote {
 		if err := gitutil.FetchRemoteDefaultBranch(cmd.Context(), proj.DefaultWorktreePath, "origin", compareCtx.DefaultBranch); err != nil {
 			fmt.Fprintf(cmd.ErrOrStderr(), "warning: %s\n", singleLineError(err))
 		}
@@ -127,28 +177,87 @@ func runTidy(cmd *cobra.Command, opts *tidyOptions) error {
 	if opts.timeoutFlag != "" && !killEnabled {
 		return fmt.Errorf("--timeout requires --kill")
 	}
+	if opts.escalate && !killEnabled {
+		return fmt.Errorf("--escalate requires --kill")
+	}
 	var killCfg killSettings
 	if killEnabled {
 		killCfg, err = resolveKillSettings(killSignalSpec, opts.timeoutFlag, proj.Config.Process.KillTimeoutDuration())
 		if err != nil {
 			return err
 		}
+		if opts.escalate {
+			if err := killCfg.enableEscalation(proj.Config.Process.EscalateSignal); err != nil {
+				return err
+			}
+		}
+	}
+
+	if opts.verbose && !opts.dryRun {
+		return fmt.Errorf("--verbose requires --dry-run")
+	}
+	if opts.parallel < 1 {
+		return fmt.Errorf("--parallel must be at least 1 (got %d)", opts.parallel)
+	}
+	sortOrder, err := parseTidySortOrder(opts.sortFlag)
+	if err != nil {
+		return err
+	}
+	var since time.Duration
+	if strings.TrimSpace(opts.sinceFlag) != "" {
+		since, err = parseDurationWithDays(opts.sinceFlag)
+		if err != nil {
+			return fmt.Errorf("invalid --since value %q (examples: 7d, 48h)", opts.sinceFlag)
+		}
 	}
 
+	phases := newPhaseTimer()
+	defer phases.report(cmd.ErrOrStderr())
+
 	now := currentTimeOverride()
-	candidates, err := collectTidyCandidates(cmd.Context(), proj, compareCtx.CompareRef, now)
+	var candidates []*tidyCandidate
+	err = phases.run(cmd.Context(), "collect git status", func() error {
+		var err error
+		candidates, err = collectTidyCandidates(cmd.Context(), proj, compareCtx, now)
+		return err
+	})
 	if err != nil {
 		return err
 	}
+	if since > 0 {
+		applyTidySinceWindow(candidates, now, since)
+	}
+	if opts.onlyMergedRemote {
+		requireRemoteMerge(candidates, proj.DefaultWorktreePath, "origin", compareCtx.DefaultBranch)
+	}
 
 	if err := attachProcessesToCandidates(candidates); err != nil {
 		return err
 	}
 
+	sortCandidates(candidates, sortOrder)
 	ui := newTidyUI(cmd.OutOrStdout(), candidates, now)
 
-	if err := fetchTidyPullRequests(cmd.Context(), candidates, ui); err != nil && !errors.Is(err, context.Canceled) {
-		fmt.Fprintf(cmd.ErrOrStderr(), "warning: %s\n", singleLineError(err))
+	ghTimeout := proj.Config.GH.TimeoutDuration()
+	ghErr := ghAuth()
+	if ghErr != nil {
+		skipped := "PR and CI lookups"
+		if proj.Config.CI.Command != "" {
+			skipped = "PR lookups"
+		}
+		if !errors.Is(ghErr, errGhDisabled) {
+			fmt.Fprintf(cmd.ErrOrStderr(), "warning: %s (skipping %s)\n", ghErr, skipped)
+		}
+		skipTidyPullRequests(candidates, ui)
+	} else {
+		err = phases.run(cmd.Context(), "fetch pull requests", func() error {
+			prCtx, cancel := ghPhaseContext(cmd.Context(), ghTimeout)
+			defer cancel()
+			return fetchTidyPullRequests(prCtx, ciRepo, candidates, proj.Config.GH.PRLimit, ui)
+		})
+		if err != nil && !errors.Is(err, context.Canceled) {
+			fmt.Fprintf(cmd.ErrOrStderr(), "warning: %s\n", singleLineError(err))
+		}
 	}
 
 	ciOpts := ciFetchOptions{
@@ -156,13 +265,21 @@ func runTidy(cmd *cobra.Command, opts *tidyOptions) error {
 		RepoErr:    ciRepoErr,
 		RemoteName: proj.Config.CIRemote(),
 		Workdir:    proj.DefaultWorktreePath,
-	}
-	if err := fetchCIStatuses(cmd.Context(), ciOpts, ui.statuses, now, nil); err != nil && !errors.Is(err, context.Canceled) {
-		fmt.Fprintf(cmd.ErrOrStderr(), "warning: %s\n", singleLineError(err))
+		Command:    proj.Config.CI.Command,
+	}
+	if ghErr == nil || ciOpts.Command != "" {
+		err = phases.run(cmd.Context(), "fetch ci status", func() error {
+			ciCtx, cancel := ghPhaseContext(cmd.Context(), ghTimeout)
+			defer cancel()
+			return fetchCIStatuses(ciCtx, ciOpts, ui.statuses, now, nil)
+		})
+		if err != nil && !errors.Is(err, context.Canceled) {
+			fmt.Fprintf(cmd.ErrOrStderr(), "warning: %s\n", singleLineError(err))
+		}
 	}
 	updateCandidatesCIState(candidates, workflow)
 
-	deriveCtx := tidyDeriveContext{Now: now, Workflow: workflow}
+	deriveCtx := tidyDeriveContext{Now: now, Workflow: workflow, NoPRIsSafe: opts.includeNoPR || proj.Config.Tidy.NoPRIsSafe}
 	safe, gray, blocked := classifyCandidates(candidates, deriveCtx, ui)
 
 	var killPlan *killSettings
@@ -180,20 +297,30 @@ func runTidy(cmd *cobra.Command, opts *tidyOptions) error {
 		}
 	}
 
+	// --policy safe still skips gray worktrees under --yes.
+	autoConfirm := opts.yes && policy != tidyPolicySafe
 	if opts.dryRun {
-		if ui.Interactive() {
+		if ui.Interactive() && !opts.verbose {
 			return nil
 		}
-		return renderDryRun(cmd.OutOrStdout(), safe, gray, blocked, now, killPlan)
+		return renderDryRun(cmd.OutOrStdout(), safe, gray, blocked, now, killPlan, autoConfirm, opts.verbose)
 	}
 
 	if !ui.Interactive() {
 		fmt.Fprintln(cmd.OutOrStdout(), "Plan:")
-		renderDryRun(cmd.OutOrStdout(), safe, gray, blocked, now, killPlan)
+		renderDryRun(cmd.OutOrStdout(), safe, gray, blocked, now, killPlan, autoConfirm, false)
 		fmt.Fprintln(cmd.OutOrStdout())
 	}
 
-	return executeTidies(cmd, proj, candidates, policy, now, ui, initialWD)
+	var report *cleanupReport
+	if opts.reportPath != "" {
+		if report, err = openCleanupReport(opts.reportPath); err != nil {
+			return err
+		}
+	}
+	remotePrune := !opts.noRemotePrune && proj.Config.Tidy.RemotePruneEnabled()
+	err = executeTidies(cmd, proj, candidates, policy, now, ui, initialWD, opts.parallel, opts.yes, remotePrune, report)
+	return errors.Join(err, report.Close())
 }
 
 func resolveTidyPolicy(opts *tidyOptions, defaultPolicy tidyPolicy) (tidyPolicy, error) {
@@ -230,14 +357,17 @@ func resolveTidyPolicy(opts *tidyOptions, defaultPolicy tidyPolicy) (tidyPolicy,
 }
 
 type tidyCandidate struct {
-	Worktree            project.Worktree
-	Branch              string
-	HeadHash            string
-	Dirty               bool
-	HasStash            bool
-	IsCurrent           bool
-	MergedIntoDefault   bool
-	TreeMatchesDefault  bool
+	Worktree           project.Worktree
+	Branch             string
+	HeadHash           string
+	Dirty              bool
+	HasStash           bool
+	IsCurrent          bool
+	MergedIntoDefault  bool
+	TreeMatchesDefault bool
+	// RemoteBranch is the branch's name on origin; see worktreeGitData.
+	RemoteBranch        string
+	RemoteTrackedBy     string
 	HasRemoteBranch     bool
 	RemoteMatchesHead   bool
 	BaseAhead           int
@@ -275,7 +405,7 @@ const (
 	tidyGray
 )
 
-func collectTidyCandidates(ctx context.Context, proj *project.Project, defaultCompareRef string, now time.Time) ([]*tidyCandidate, error) {
+func collectTidyCandidates(ctx context.Context, proj *project.Project, compareCtx defaultBranchCompareContext, now time.Time) ([]*tidyCandidate, error) {
 	worktrees, err := project.ListWorktrees(proj.Root)
 	if err != nil {
 		return nil, err
@@ -286,13 +416,20 @@ func collectTidyCandidates(ctx context.Context, proj *project.Project, defaultCo
 		return nil, err
 	}
 
+	// Stashes are repo-wide: one made on a branch from any worktree must
+	// keep that branch alive.
+	stashBranches, err := gitutil.StashBranches(proj.DefaultWorktreePath)
+	if err != nil {
+		return nil, err
+	}
+
 	branchUsage := make(map[string][]string)
 	base := make([]*tidyCandidate, 0, len(worktrees))
 	for _, wt := range worktrees {
 		if wt.Name == proj.DefaultWorktree {
 			continue
 		}
-		cand, err := inspectWorktreeBase(ctx, proj, wt, wd, defaultCompareRef)
+		cand, err := inspectWorktreeBase(ctx, proj, wt, wd, compareCtx, stashBranches)
 		if err != nil {
 			return nil, err
 		}
@@ -310,14 +447,17 @@ func collectTidyCandidates(ctx context.Context, proj *project.Project, defaultCo
 	return base, nil
 }
 
-func inspectWorktreeBase(ctx context.Context, proj *project.Project, wt project.Worktree, wd string, defaultCompareRef string) (*tidyCandidate, error) {
+func inspectWorktreeBase(ctx context.Context, proj *project.Project, wt project.Worktree, wd string, compareCtx defaultBranchCompareContext, stashBranches map[string]bool) (*tidyCandidate, error) {
 	cand := &tidyCandidate{
 		Worktree:      wt,
 		Stage:         tidyStageScanning,
-		defaultBranch: proj.Config.DefaultBranch,
+		defaultBranch: compareCtx.DefaultBranch,
 	}
 
-	data, err := gatherWorktreeGitData(ctx, proj, wt, defaultCompareRef, gatherWorktreeGitDataOptionsFull)
+	gatherOpts := gatherWorktreeGitDataOptionsFull
+	gatherOpts.StashBranches = stashBranches
+	gatherOpts.TargetBranch = compareCtx.DefaultBranch
+	data, err := gatherWorktreeGitData(ctx, proj, wt, compareCtx.CompareRef, gatherOpts)
 	if err != nil {
 		cand.Branch = "(unknown)"
 		return markTidyGitError(cand, err)
@@ -327,8 +467,17 @@ func inspectWorktreeBase(ctx context.Context, proj *project.Project, wt project.
 	if cand.Branch == "" || cand.Branch == "HEAD" {
 		cand.BlockReasons = append(cand.BlockReasons, "detached HEAD")
 	}
-	if cand.Branch == proj.Config.DefaultBranch {
-		cand.BlockReasons = append(cand.BlockReasons, fmt.Sprintf("branch is the default (%s)", proj.Config.DefaultBranch))
+	if data.Unborn {
+		cand.BlockReasons = append(cand.BlockReasons, "branch has no commits yet")
+	}
+	if kind := protectedBranchKind(proj, cand.Branch); kind != "" {
+		cand.BlockReasons = append(cand.BlockReasons, fmt.Sprintf("on %s (%s)", kind, cand.Branch))
+	}
+	if wt.Locked {
+		cand.BlockReasons = append(cand.BlockReasons, "worktree is locked")
+	}
+	if wt.Main {
+		cand.BlockReasons = append(cand.BlockReasons, blockReasonMainWorkingTree)
 	}
 
 	cand.IsCurrent = isWithin(wd, wt.Path)
@@ -353,6 +502,8 @@ func inspectWorktreeBase(ctx context.Context, proj *project.Project, wt project.
 	cand.MergedIntoDefault = data.MergedIntoDefault
 	cand.TreeMatchesDefault = data.TreeMatchesDefault
 	cand.UniqueAhead = data.UniqueAhead
+	cand.RemoteBranch = data.RemoteBranch
+	cand.RemoteTrackedBy = data.RemoteTrackedBy
 	cand.HasRemoteBranch = data.HasRemoteBranch
 	cand.RemoteMatchesHead = data.RemoteMatchesHead
 
@@ -366,6 +517,59 @@ func inspectWorktreeBase(ctx context.Context, proj *project.Project, wt project.
 	return cand, nil
 }
 
+// protectedBranchKind names the role of branch when tidy and rm must never
+// delete it: the default branch, or the integration branch that merges target.
+// It returns "" for ordinary branches.
+func protectedBranchKind(proj *project.Project, branch string) string {
+	switch branch {
+	case "":
+		return ""
+	case proj.Config.DefaultBranch:
+		return "default branch"
+	case proj.Config.MergeTarget():
+		return "integration branch"
+	}
+	return ""
+}
+
+// applyTidySinceWindow blocks candidates whose last activity falls inside the
+// --since window so ad-hoc runs only reap long-idle worktrees.
+func applyTidySinceWindow(candidates []*tidyCandidate, now time.Time, window time.Duration) {
+	cutoff := now.Add(-window)
+	for _, cand := range candidates {
+		if cand == nil || !cand.LastActivity.After(cutoff) {
+			continue
+		}
+		cand.BlockReasons = append(cand.BlockReasons, blockReasonSinceWindow)
+		cand.Stage = tidyStageBlocked
+	}
+}
+
+// requireRemoteMerge grays candidates whose HEAD is not an ancestor of
+// <remote>/<default>, so a stale local default branch can't make a worktree
+// look merged.
+func requireRemoteMerge(candidates []*tidyCandidate, repoDir, remote, defaultBranch string) {
+	remoteRef := remote + "/" + defaultBranch
+	remoteExists := gitutil.RefExists(repoDir, "refs/remotes/"+remoteRef)
+	for _, cand := range candidates {
+		if cand == nil || len(cand.BlockReasons) > 0 {
+			continue
+		}
+		if !remoteExists {
+			cand.extraGrayReasons = append(cand.extraGrayReasons, fmt.Sprintf("%s not found", remoteRef))
+			continue
+		}
+		merged, err := gitutil.HeadMergedInto(cand.Worktree.Path, remoteRef)
+		if err != nil {
+			cand.extraGrayReasons = append(cand.extraGrayReasons, fmt.Sprintf("merge check against %s failed: %s", remoteRef, singleLineError(err)))
+			continue
+		}
+		if !merged {
+			cand.extraGrayReasons = append(cand.extraGrayReasons, fmt.Sprintf("not merged into %s", remoteRef))
+		}
+	}
+}
+
 func markTidyGitError(cand *tidyCandidate, err error) (*tidyCandidate, error) {
 	msg := fmt.Sprintf("git error: %s", singleLineError(err))
 	if friendly, ok := friendlyWorktreeGitError(cand.Worktree.Name, err); ok {
@@ -382,7 +586,30 @@ func markTidyGitError(cand *tidyCandidate, err error) (*tidyCandidate, error) {
 	return cand, nil
 }
 
-func fetchTidyPullRequests(ctx context.Context, candidates []*tidyCandidate, ui *tidyUI) error {
+// skipTidyPullRequests stands in for fetchTidyPullRequests when gh cannot be
+// used. Only candidates with pending work need PR data to be judged, so only
+// they turn gray; merged, clean worktrees can still be tidied.
+func skipTidyPullRequests(candidates []*tidyCandidate, ui *tidyUI) {
+	for _, cand := range markPullRequestsSkipped(candidates) {
+		ui.Update(cand)
+	}
+}
+
+// markPullRequestsSkipped grays the candidates whose pending work needed PR
+// data and returns them.
+func markPullRequestsSkipped(candidates []*tidyCandidate) []*tidyCandidate {
+	var marked []*tidyCandidate
+	for _, cand := range candidates {
+		if len(cand.BlockReasons) > 0 || !cand.hasPendingWork() {
+			continue
+		}
+		cand.extraGrayReasons = append(cand.extraGrayReasons, "PR lookup skipped")
+		marked = append(marked, cand)
+	}
+	return marked
+}
+
+func fetchTidyPullRequests(ctx context.Context, repo *forgeRepo, candidates []*tidyCandidate, prLimit int, ui *tidyUI) error {
 	type result struct {
 		cand *tidyCandidate
 		prs  []pullRequestInfo
@@ -399,7 +626,7 @@ func fetchTidyPullRequests(ctx context.Context, candidates []*tidyCandidate, ui
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			prs, err := queryPullRequests(ctx, cand.Worktree.Path, cand.Branch)
+			prs, err := queryPullRequests(ctx, repo, cand.Worktree.Path, cand.Branch, prLimit)
 			if errors.Is(err, context.Canceled) {
 				return
 			}
@@ -467,6 +694,9 @@ func deriveClassification(cand *tidyCandidate, deriveCtx tidyDeriveContext) {
 	hasUniqueCommits := cand.UniqueAhead > 0
 	openPRs := openPullRequests(cand.PRs)
 	needsCleanupDecision := hasUniqueCommits
+	if deriveCtx.NoPRIsSafe && len(cand.PRs) == 0 && (cand.MergedIntoDefault || cand.TreeMatchesDefault) {
+		needsCleanupDecision = false
+	}
 	if needsCleanupDecision {
 		reasons = append(reasons, fmt.Sprintf("commits not merged into %s", cand.defaultBranch))
 		if len(openPRs) > 0 {
@@ -482,18 +712,10 @@ func deriveClassification(cand *tidyCandidate, deriveCtx tidyDeriveContext) {
 				reasons = append(reasons, summary.Reason)
 			}
 		}
-		if cand.divergenceThreshold > 0 {
-			divergence := maxInt(absInt(cand.BaseAhead), absInt(cand.BaseBehind))
-			if divergence > cand.divergenceThreshold {
-				reasons = append(reasons, fmt.Sprintf("diverged +%d/-%d from %s", cand.BaseAhead, cand.BaseBehind, cand.defaultBranch))
-			}
-		}
-		if cand.staleCutoffDays > 0 {
-			daysOld := int(deriveCtx.Now.Sub(cand.LastActivity).Hours() / 24)
-			if daysOld > cand.staleCutoffDays {
-				reasons = append(reasons, fmt.Sprintf("stale for %d days", daysOld))
-			}
-		}
+		reasons = append(reasons, tidyThresholdReasons(tidyThresholds{
+			DivergenceCommits: cand.divergenceThreshold,
+			StaleDays:         cand.staleCutoffDays,
+		}, cand.BaseAhead, cand.BaseBehind, cand.LastActivity, deriveCtx.Now, cand.defaultBranch)...)
 	}
 
 	if len(cand.PRs) > 1 {
@@ -521,12 +743,39 @@ func deriveClassification(cand *tidyCandidate, deriveCtx tidyDeriveContext) {
 	}
 }
 
-func renderDryRun(out io.Writer, safe, gray, blocked []*tidyCandidate, now time.Time, killPlan *killSettings) error {
+// tidyThresholds are the [tidy] divergence and staleness limits; zero disables
+// a check.
+type tidyThresholds struct {
+	DivergenceCommits int
+	StaleDays         int
+}
+
+// tidyThresholdReasons returns the gray reasons for a branch with unique
+// commits that has drifted too far from the default branch or gone idle too
+// long. wt status --divergence/--stale-days previews the same checks.
+func tidyThresholdReasons(limits tidyThresholds, baseAhead, baseBehind int, lastActivity, now time.Time, defaultBranch string) []string {
+	var reasons []string
+	if limits.DivergenceCommits > 0 {
+		divergence := maxInt(absInt(baseAhead), absInt(baseBehind))
+		if divergence > limits.DivergenceCommits {
+			reasons = append(reasons, fmt.Sprintf("diverged +%d/-%d from %s", baseAhead, baseBehind, defaultBranch))
+		}
+	}
+	if limits.StaleDays > 0 {
+		daysOld := int(now.Sub(lastActivity).Hours() / 24)
+		if daysOld > limits.StaleDays {
+			reasons = append(reasons, fmt.Sprintf("stale for %d days", daysOld))
+		}
+	}
+	return reasons
+}
+
+func renderDryRun(out io.Writer, safe, gray, blocked []*tidyCandidate, now time.Time, killPlan *killSettings, autoConfirm, verbose bool) error {
 	sections := 0
 	if killPlan != nil {
 		targets := append([]*tidyCandidate{}, safe...)
 		targets = append(targets, gray...)
-		renderKillPreview(out, targets, killPlan.SignalLabel)
+		renderKillPreview(out, targets, killPlan.SignalLabel, now)
 	}
 	if len(safe) > 0 {
 		sections++
@@ -536,18 +785,28 @@ func renderDryRun(out io.Writer, safe, gray, blocked []*tidyCandidate, now time.
 			for _, action := range plannedActions(cand) {
 				fmt.Fprintf(out, "    %s\n", action)
 			}
+			if verbose {
+				renderCandidateFacts(out, cand, now)
+			}
 		}
 		fmt.Fprintln(out)
 	}
 	if len(gray) > 0 {
 		sections++
-		fmt.Fprintln(out, "Will prompt for:")
+		if autoConfirm {
+			fmt.Fprintln(out, "Will clean up (auto-confirmed):")
+		} else {
+			fmt.Fprintln(out, "Will prompt for:")
+		}
 		for _, cand := range gray {
 			fmt.Fprintf(out, "- %s (branch %s)\n", cand.Worktree.Name, cand.Branch)
 			fmt.Fprintln(out, "    reasons:")
 			for _, reason := range cand.GrayReasons {
 				fmt.Fprintf(out, "      * %s\n", reason)
 			}
+			if verbose {
+				renderCandidateFacts(out, cand, now)
+			}
 		}
 		fmt.Fprintln(out)
 	}
@@ -556,6 +815,9 @@ func renderDryRun(out io.Writer, safe, gray, blocked []*tidyCandidate, now time.
 		fmt.Fprintln(out, "Will skip:")
 		for _, cand := range blocked {
 			fmt.Fprintf(out, "- %s (%s)\n", cand.Worktree.Name, strings.Join(cand.BlockReasons, "; "))
+			if verbose {
+				renderCandidateFacts(out, cand, now)
+			}
 		}
 	}
 	if sections == 0 {
@@ -569,7 +831,30 @@ func renderDryRun(out io.Writer, safe, gray, blocked []*tidyCandidate, now time.
 	return nil
 }
 
-func renderKillPreview(out io.Writer, candidates []*tidyCandidate, signalLabel string) bool {
+// renderCandidateFacts prints the raw git facts the classifier used so
+// `--dry-run --verbose` explains why a worktree landed where it did.
+func renderCandidateFacts(out io.Writer, cand *tidyCandidate, now time.Time) {
+	remote := "none"
+	if cand.HasRemoteBranch {
+		remote = "matches HEAD"
+		if !cand.RemoteMatchesHead {
+			remote = "differs from HEAD"
+		}
+	}
+	activity := "unknown"
+	if !cand.LastActivity.IsZero() {
+		activity = timefmt.Relative(cand.LastActivity, now)
+	}
+	fmt.Fprintln(out, "    facts:")
+	fmt.Fprintf(out, "      merged into %s: %s\n", cand.defaultBranch, boolLabel(cand.MergedIntoDefault))
+	fmt.Fprintf(out, "      tree matches %s: %s\n", cand.defaultBranch, boolLabel(cand.TreeMatchesDefault))
+	fmt.Fprintf(out, "      unique commits: %d\n", cand.UniqueAhead)
+	fmt.Fprintf(out, "      vs %s: +%d -%d\n", cand.defaultBranch, cand.BaseAhead, cand.BaseBehind)
+	fmt.Fprintf(out, "      remote branch: %s\n", remote)
+	fmt.Fprintf(out, "      last activity: %s\n", activity)
+}
+
+func renderKillPreview(out io.Writer, candidates []*tidyCandidate, signalLabel string, now time.Time) bool {
 	printed := false
 	for _, cand := range candidates {
 		if len(cand.Processes) == 0 {
@@ -581,7 +866,7 @@ func renderKillPreview(out io.Writer, candidates []*tidyCandidate, signalLabel s
 		}
 		fmt.Fprintf(out, "- %s\n", cand.Worktree.Name)
 		for _, proc := range cand.Processes {
-			fmt.Fprintf(out, "    %s (%d)\n", processCommandLabel(proc.Command), proc.PID)
+			fmt.Fprintf(out, "    %s\n", processListingLabel(proc, now))
 		}
 		fmt.Fprintf(out, "    signal: %s\n", signalLabel)
 	}
@@ -597,16 +882,19 @@ func plannedActions(cand *tidyCandidate) []string {
 		fmt.Sprintf("delete local branch %s", cand.Branch),
 	}
 	if cand.HasRemoteBranch {
-		if cand.RemoteMatchesHead {
-			actions = append(actions, fmt.Sprintf("delete remote branch origin/%s", cand.Branch))
-		} else {
-			actions = append(actions, fmt.Sprintf("skip remote branch origin/%s (tip changed)", cand.Branch))
+		switch {
+		case cand.RemoteTrackedBy != "":
+			actions = append(actions, fmt.Sprintf("skip remote branch origin/%s (upstream of %s)", cand.remoteBranchName(), cand.RemoteTrackedBy))
+		case cand.RemoteMatchesHead:
+			actions = append(actions, fmt.Sprintf("delete remote branch origin/%s", cand.remoteBranchName()))
+		default:
+			actions = append(actions, fmt.Sprintf("skip remote branch origin/%s (tip changed)", cand.remoteBranchName()))
 		}
 	}
 	return actions
 }
 
-func executeTidies(cmd *cobra.Command, proj *project.Project, candidates []*tidyCandidate, policy tidyPolicy, now time.Time, ui *tidyUI, initialWD string) error {
+func executeTidies(cmd *cobra.Command, proj *project.Project, candidates []*tidyCandidate, policy tidyPolicy, now time.Time, ui *tidyUI, initialWD string, parallel int, assumeYes, remotePrune bool, report *cleanupReport) error {
 	out := cmd.OutOrStdout()
 	reader := bufio.NewReader(cmd.InOrStdin())
 	logWriter := out
@@ -614,6 +902,13 @@ func executeTidies(cmd *cobra.Command, proj *project.Project, candidates []*tidy
 		logWriter = nil
 	}
 
+	// Prompts need ordered user input, so any run that may prompt stays sequential.
+	workers := parallel
+	if workers > 1 && !assumeYes && tidyNeedsPrompt(candidates, policy) {
+		workers = 1
+	}
+	var queue []*tidyCandidate
+
 	var remoteTouched bool
 	var manualQuit bool
 	var relocated bool
@@ -646,11 +941,15 @@ func executeTidies(cmd *cobra.Command, proj *project.Project, candidates []*tidy
 			continue
 		}
 
-		prompt := shouldPrompt(cand.Classification, policy)
+		prompt := !assumeYes && shouldPrompt(cand.Classification, policy)
 		if prompt {
 			proceed, quit, lines, err := promptForCandidate(out, reader, cand, now, ui.Interactive())
 			if ui.Interactive() {
-				ui.AddExtraLines(lines)
+				if lines == promptLinesUnknown {
+					ui.ClearScreen()
+				} else {
+					ui.AddExtraLines(lines)
+				}
 			}
 			if err != nil {
 				return err
@@ -682,7 +981,12 @@ func executeTidies(cmd *cobra.Command, proj *project.Project, candidates []*tidy
 		cand.Stage = tidyStageCleaning
 		ui.Update(cand)
 
-		touched, err := performCleanup(cmd.Context(), logWriter, proj, cand)
+		if workers > 1 {
+			queue = append(queue, cand)
+			continue
+		}
+
+		touched, err := performCleanup(cmd.Context(), logWriter, report, proj, cand, nil)
 		if err != nil {
 			cand.Stage = tidyStageError
 			ui.Update(cand)
@@ -696,12 +1000,130 @@ func executeTidies(cmd *cobra.Command, proj *project.Project, candidates []*tidy
 		ui.Update(cand)
 	}
 
-	if remoteTouched {
+	var combined error
+	if len(queue) > 0 {
+		touched, err := performCleanupsConcurrently(cmd.Context(), logWriter, report, proj, queue, workers, ui)
+		if touched {
+			remoteTouched = true
+		}
+		combined = err
+	}
+
+	pruned := false
+	pruneRan := remoteTouched && remotePrune
+	if pruneRan {
 		if err := pruneRemote(logWriter, proj.DefaultWorktreePath); err != nil {
-			return err
+			combined = errors.Join(combined, err)
+		} else {
+			pruned = true
 		}
+	} else if remoteTouched && logWriter != nil {
+		fmt.Fprintln(logWriter, "Skipped remote prune; run `git remote prune origin` when convenient")
 	}
-	return nil
+	fmt.Fprintln(out, formatTidySummary(candidates, currentTimeOverride().Sub(now), pruneRan, pruned))
+	return combined
+}
+
+// formatTidySummary is the closing line of a tidy run. The elapsed time comes
+// from currentTimeOverride, so it reads 0s under WT_NOW in transcripts.
+func formatTidySummary(candidates []*tidyCandidate, elapsed time.Duration, pruneRan, pruned bool) string {
+	var tidied, skipped, blocked, failed int
+	for _, cand := range candidates {
+		switch cand.Stage {
+		case tidyStageCleaned:
+			tidied++
+		case tidyStageSkipped:
+			skipped++
+		case tidyStageBlocked:
+			blocked++
+		case tidyStageError:
+			failed++
+		}
+	}
+	summary := fmt.Sprintf("Tidied %d %s, skipped %d, blocked %d", tidied, pluralize(tidied, "worktree", "worktrees"), skipped, blocked)
+	if failed > 0 {
+		summary += fmt.Sprintf(", failed %d", failed)
+	}
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	summary += fmt.Sprintf(" in %s", elapsed.Round(100*time.Millisecond))
+	switch {
+	case pruned:
+		summary += "; pruned origin"
+	case pruneRan:
+		summary += "; prune of origin failed"
+	}
+	return summary
+}
+
+func tidyNeedsPrompt(candidates []*tidyCandidate, policy tidyPolicy) bool {
+	for _, cand := range candidates {
+		if cand.Classification == tidyBlocked {
+			continue
+		}
+		if policy == tidyPolicySafe && cand.Classification == tidyGray {
+			continue
+		}
+		if shouldPrompt(cand.Classification, policy) {
+			return true
+		}
+	}
+	return false
+}
+
+// performCleanupsConcurrently runs performCleanup for queued candidates with at
+// most workers in flight. Worktree removal and local branch deletion share one
+// lock, since parallel git commands in the same repository trip over each
+// other's index and ref locks; only the remote branch pushes overlap. Each
+// candidate's log is buffered and flushed whole so output stays readable, and
+// failures are collected rather than aborting the batch.
+func performCleanupsConcurrently(ctx context.Context, log io.Writer, report *cleanupReport, proj *project.Project, queue []*tidyCandidate, workers int, ui *tidyUI) (bool, error) {
+	var (
+		mu            sync.Mutex
+		localMu       sync.Mutex
+		wg            sync.WaitGroup
+		remoteTouched bool
+		combined      error
+	)
+	sem := make(chan struct{}, workers)
+	for _, cand := range queue {
+		cand := cand
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			var buf bytes.Buffer
+			var candLog io.Writer
+			if log != nil {
+				candLog = &buf
+			}
+			touched, err := performCleanup(ctx, candLog, report, proj, cand, &localMu)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if touched {
+				remoteTouched = true
+			}
+			if err != nil {
+				cand.Stage = tidyStageError
+				if log != nil {
+					fmt.Fprintf(&buf, "  error: %s\n", singleLineError(err))
+				}
+				combined = errors.Join(combined, fmt.Errorf("%s: %w", cand.Worktree.Name, err))
+			} else {
+				cand.Stage = tidyStageCleaned
+			}
+			if log != nil {
+				_, _ = log.Write(buf.Bytes())
+			}
+			ui.Update(cand)
+		}()
+	}
+	wg.Wait()
+	return remoteTouched, combined
 }
 
 func tidyKillProcesses(cmd *cobra.Command, safe, gray []*tidyCandidate, settings killSettings, dryRun bool, ui *tidyUI) (bool, error) {
@@ -733,7 +1155,13 @@ func tidyKillProcesses(cmd *cobra.Command, safe, gray []*tidyCandidate, settings
 		if logWriter != nil {
 			fmt.Fprintf(logWriter, "Killing processes in %s (signal %s)\n", cand.Worktree.Name, settings.SignalLabel)
 		}
-		err := terminateWorktreeProcesses(cmd.Context(), cand.Worktree, cand.Processes, settings, terminator)
+		hooks := killHooks{}
+		if logWriter != nil {
+			hooks.Escalating = func(remaining []processes.Process) {
+				fmt.Fprintf(logWriter, "  escalating to %s for %d %s\n", settings.EscalateSignalLabel, len(remaining), pluralize(len(remaining), "process", "processes"))
+			}
+		}
+		err := terminateWorktreeProcesses(cmd.Context(), cand.Worktree, cand.Processes, settings, terminator, hooks)
 		if err != nil {
 			if errors.Is(err, errProcessUnsupported) || errors.Is(err, context.Canceled) {
 				return changed, err
@@ -821,6 +1249,9 @@ func promptForCandidate(out io.Writer, reader *bufio.Reader, cand *tidyCandidate
 				if line == "" {
 					continue
 				}
+				if text, ok := strings.CutSuffix(line, uniqueCommitTag); ok {
+					line = text + colorPromptWarn(uniqueCommitTag)
+				}
 				fmt.Fprintf(&b, "    %s\n", line)
 			}
 		}
@@ -848,24 +1279,59 @@ func promptForCandidate(out io.Writer, reader *bufio.Reader, cand *tidyCandidate
 	}
 
 	panel := b.String()
-	fmt.Fprint(out, panel)
-	prompt := "Proceed with cleanup? [y/N/q]: "
+	prompt := "Proceed with cleanup? [y/N/d/q] (d shows the diff): "
 	if useColor {
 		prompt = colorPromptLabel(prompt)
 	}
-	fmt.Fprint(out, prompt)
 
-	resp, err := reader.ReadString('\n')
-	if err != nil && !errors.Is(err, io.EOF) {
-		return false, false, strings.Count(panel, "\n") + 2, err
+	lines := 0
+	paged := false
+	for {
+		fmt.Fprint(out, panel)
+		fmt.Fprint(out, prompt)
+		lines += strings.Count(panel, "\n") + 2
+
+		resp, err := reader.ReadString('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return false, false, lines, err
+		}
+		fmt.Fprintln(out)
+
+		resp = strings.TrimSpace(strings.ToLower(resp))
+		if (resp == "d" || resp == "diff") && err == nil {
+			// The diff may go through a pager, so the caller can no longer
+			// tell how many lines to repaint over.
+			paged = true
+			if err := showCandidateDiff(out, cand); err != nil {
+				fmt.Fprintf(out, "diff unavailable: %s\n", singleLineError(err))
+			}
+			continue
+		}
+		ok := resp == "y" || resp == "yes"
+		quit := resp == "q" || resp == "quit"
+		if paged {
+			lines = promptLinesUnknown
+		}
+		return ok, quit, lines, nil
 	}
-	fmt.Fprintln(out)
+}
 
-	resp = strings.TrimSpace(strings.ToLower(resp))
-	ok := resp == "y" || resp == "yes"
-	quit := resp == "q" || resp == "quit"
-	lines := strings.Count(panel, "\n") + 2
-	return ok, quit, lines, nil
+// promptLinesUnknown is reported by promptForCandidate when output of unknown
+// height (e.g. a paged diff) was shown, so the dashboard must redraw from a
+// cleared screen.
+const promptLinesUnknown = -1
+
+// showCandidateDiff shows the commits (with patches) that removing the
+// candidate would discard. git pages the output itself when out is a terminal.
+func showCandidateDiff(out io.Writer, cand *tidyCandidate) error {
+	if cand == nil || cand.Worktree.Path == "" || cand.defaultBranch == "" {
+		return errors.New("no default branch to compare against")
+	}
+	cmd := exec.Command("git", "-C", cand.Worktree.Path, "log", "-p", cand.defaultBranch+"..HEAD")
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = out
+	cmd.Stderr = out
+	return cmd.Run()
 }
 
 func promptDivider(titleLen int) string {
@@ -879,15 +1345,24 @@ func promptDivider(titleLen int) string {
 	return strings.Repeat("-", width)
 }
 
+// uniqueCommitTag marks prompt graph commits that deleting the branch would
+// lose for good.
+const uniqueCommitTag = "⚠ not anywhere else"
+
+// promptCommitGraph renders the commits the branch has over the default branch
+// as `git log --oneline --graph`, tagging those that no other local branch or
+// remote-tracking ref contains.
 func promptCommitGraph(cand *tidyCandidate) (string, error) {
 	if cand == nil || cand.Worktree.Path == "" || cand.Branch == "" || cand.defaultBranch == "" {
 		return "", nil
 	}
 	args := []string{
 		"log",
-		"--oneline",
 		"--graph",
 		"--decorate",
+		// NUL-delimited full hash lets each line be matched against the
+		// unique set; the rest mirrors --oneline.
+		"--format=%x00%H%x00%h%d %s",
 		fmt.Sprintf("--max-count=%d", tidyPromptLogLimit),
 		cand.Branch,
 		"--not",
@@ -897,7 +1372,44 @@ func promptCommitGraph(cand *tidyCandidate) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	return strings.TrimRight(out, "\n"), nil
+	unique, err := commitsOnlyOnBranch(cand)
+	if err != nil {
+		return "", err
+	}
+	lines := strings.Split(strings.TrimRight(out, "\n"), "\n")
+	for i, line := range lines {
+		prefix, rest, ok := strings.Cut(line, "\x00")
+		if !ok {
+			continue
+		}
+		hash, text, _ := strings.Cut(rest, "\x00")
+		lines[i] = prefix + text
+		if unique[hash] {
+			lines[i] += "  " + uniqueCommitTag
+		}
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// commitsOnlyOnBranch lists the commits reachable from the candidate's branch
+// but not from the default branch, any other local branch, or any
+// remote-tracking ref.
+func commitsOnlyOnBranch(cand *tidyCandidate) (map[string]bool, error) {
+	out, err := runGitCapture(cand.Worktree.Path, nil,
+		"rev-list", cand.Branch,
+		"--not", cand.defaultBranch,
+		// --branches matches --exclude against the name without refs/heads/.
+		"--exclude="+cand.Branch, "--branches",
+		"--remotes",
+	)
+	if err != nil {
+		return nil, err
+	}
+	unique := make(map[string]bool)
+	for _, hash := range strings.Fields(out) {
+		unique[hash] = true
+	}
+	return unique, nil
 }
 
 var (
@@ -917,32 +1429,91 @@ func boolLabel(v bool) string {
 	return "no"
 }
 
-func performCleanup(ctx context.Context, log io.Writer, proj *project.Project, cand *tidyCandidate) (bool, error) {
+// performCleanup removes cand's worktree and local branch, then its remote
+// branch. localMu, when set, is held for the local steps.
+func performCleanup(ctx context.Context, log io.Writer, report *cleanupReport, proj *project.Project, cand *tidyCandidate, localMu *sync.Mutex) (bool, error) {
 	if log != nil {
 		fmt.Fprintf(log, "Cleaning %s (branch %s)\n", cand.Worktree.Name, cand.Branch)
 	}
-	if err := gitWorktreeRemove(proj.DefaultWorktreePath, cand.Worktree.Path, log); err != nil {
-		return false, err
+	rec := func(action string, err error) {
+		entry := cleanupRecord{Action: action, Worktree: cand.Worktree.Name, Branch: cand.Branch}
+		switch action {
+		case cleanupActionRemoveWorktree:
+			entry.Path = cand.Worktree.Path
+		case cleanupActionDeleteBranch:
+			entry.Commit = cand.HeadHash
+		case cleanupActionDeleteRemoteBranch:
+			entry.RemoteBranch = cand.remoteBranchName()
+			entry.Commit = cand.HeadHash
+		case cleanupActionSkipRemoteBranch:
+			entry.RemoteBranch = cand.remoteBranchName()
+		}
+		if err != nil {
+			entry.Error = singleLineError(err)
+		}
+		report.record(entry)
+	}
+	if localMu != nil {
+		localMu.Lock()
+	}
+	err := func() error {
+		if err := gitWorktreeRemove(proj.DefaultWorktreePath, cand.Worktree.Path, log); err != nil {
+			return err
+		}
+		rec(cleanupActionRemoveWorktree, nil)
+		if err := gitDeleteLocalBranch(proj.DefaultWorktreePath, cand.Branch, log); err != nil {
+			return err
+		}
+		rec(cleanupActionDeleteBranch, nil)
+		return nil
+	}()
+	if localMu != nil {
+		localMu.Unlock()
 	}
-	if err := gitDeleteLocalBranch(proj.DefaultWorktreePath, cand.Branch, log); err != nil {
+	if err != nil {
+		rec(cleanupActionError, err)
 		return false, err
 	}
 
 	remoteTouched := false
 	if cand.HasRemoteBranch {
-		if cand.RemoteMatchesHead {
-			if err := gitDeleteRemoteBranch(proj.DefaultWorktreePath, cand.Branch, log); err != nil {
+		if cand.deletesRemoteBranch() {
+			if err := gitDeleteRemoteBranch(proj.DefaultWorktreePath, cand.remoteBranchName(), log); err != nil {
+				rec(cleanupActionError, err)
 				return remoteTouched, err
 			}
+			rec(cleanupActionDeleteRemoteBranch, nil)
 			remoteTouched = true
-		} else if log != nil {
-			fmt.Fprintf(log, "  skipped remote branch origin/%s (tip changed)\n", cand.Branch)
+		} else {
+			rec(cleanupActionSkipRemoteBranch, nil)
+			if log != nil {
+				reason := "tip changed"
+				if cand.RemoteTrackedBy != "" {
+					reason = "upstream of " + cand.RemoteTrackedBy
+				}
+				fmt.Fprintf(log, "  skipped remote branch origin/%s (%s)\n", cand.remoteBranchName(), reason)
+			}
 		}
 	}
 
 	return remoteTouched, nil
 }
 
+// remoteBranchName is the origin branch cleanup deletes, which is the local
+// branch name unless the branch was pushed under another name.
+func (c *tidyCandidate) remoteBranchName() string {
+	if c.RemoteBranch != "" {
+		return c.RemoteBranch
+	}
+	return c.Branch
+}
+
+// deletesRemoteBranch reports whether cleanup removes c's origin branch: it
+// must still point at HEAD and be no other local branch's upstream.
+func (c *tidyCandidate) deletesRemoteBranch() bool {
+	return c.HasRemoteBranch && c.RemoteMatchesHead && c.RemoteTrackedBy == ""
+}
+
 func gitWorktreeRemove(repoDir, path string, log io.Writer) error {
 	if err := makeTreeWritable(path); err != nil {
 		return fmt.Errorf("reset permissions: %w", err)
@@ -950,12 +1521,33 @@ func gitWorktreeRemove(repoDir, path string, log io.Writer) error {
 	if err := runGit(repoDir, nil, "worktree", "remove", "--force", path); err != nil {
 		return err
 	}
+	if err := sweepWorktreeDir(path); err != nil {
+		return err
+	}
 	if log != nil {
 		fmt.Fprintf(log, "  removed worktree %s\n", path)
 	}
 	return nil
 }
 
+// sweepWorktreeDir deletes whatever `git worktree remove` left behind, such as
+// read-only directories created by bootstrap scripts after the first
+// makeTreeWritable pass. git has already unregistered the worktree by now.
+func sweepWorktreeDir(path string) error {
+	if _, err := os.Lstat(path); errors.Is(err, fs.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := makeTreeWritable(path); err != nil {
+		return fmt.Errorf("reset permissions: %w", err)
+	}
+	if err := os.RemoveAll(path); err != nil {
+		return fmt.Errorf("remove leftover worktree directory %s: %w", path, err)
+	}
+	return nil
+}
+
 func gitDeleteLocalBranch(repoDir, branch string, log io.Writer) error {
 	if err := runGit(repoDir, nil, "branch", "-D", branch); err != nil {
 		return err
@@ -1081,7 +1673,6 @@ type tidyUI struct {
 }
 
 func newTidyUI(out io.Writer, candidates []*tidyCandidate, now time.Time) *tidyUI {
-	sortCandidatesForDisplay(candidates)
 	statuses := make([]*worktreeStatus, len(candidates))
 	for i, cand := range candidates {
 		status := candidateToStatus(cand, now)
@@ -1090,7 +1681,7 @@ func newTidyUI(out io.Writer, candidates []*tidyCandidate, now time.Time) *tidyU
 	}
 
 	width, interactive := terminalWidth(out)
-	layout := buildColumnLayout(statuses, now, width)
+	layout := buildColumnLayout(statuses, now, width, false)
 	layout.useColor = interactive
 
 	var renderer *statusRenderer
@@ -1125,6 +1716,14 @@ func (ui *tidyUI) AddExtraLines(n int) {
 	}
 }
 
+// ClearScreen makes the next render start from a cleared screen, for when
+// output of unknown height has scrolled the dashboard away.
+func (ui *tidyUI) ClearScreen() {
+	if ui.Interactive() {
+		ui.renderer.clear = true
+	}
+}
+
 func candidateToStatus(cand *tidyCandidate, now time.Time) *worktreeStatus {
 	status := &worktreeStatus{
 		Name:       cand.Worktree.Name,
@@ -1288,14 +1887,40 @@ func filterOtherWorktrees(names []string, current string) []string {
 	return result
 }
 
-func sortCandidatesForDisplay(cands []*tidyCandidate) {
+func parseTidySortOrder(value string) (tidySortOrder, error) {
+	switch order := tidySortOrder(strings.ToLower(strings.TrimSpace(value))); order {
+	case "":
+		return tidySortNewest, nil
+	case tidySortNewest, tidySortOldest, tidySortName, tidySortDivergence:
+		return order, nil
+	default:
+		return "", fmt.Errorf("invalid --sort value %q (use newest, oldest, name, or divergence)", value)
+	}
+}
+
+// sortCandidates orders candidates for both the dashboard and executeTidies.
+// Ties fall back to the worktree name. Divergence puts the branches furthest
+// from the default branch (ahead plus behind) first.
+func sortCandidates(cands []*tidyCandidate, order tidySortOrder) {
 	sort.SliceStable(cands, func(i, j int) bool {
-		ti := cands[i].LastActivity
-		tj := cands[j].LastActivity
-		if ti.Equal(tj) {
-			return cands[i].Worktree.Name < cands[j].Worktree.Name
+		a, b := cands[i], cands[j]
+		switch order {
+		case tidySortOldest:
+			if !a.LastActivity.Equal(b.LastActivity) {
+				return a.LastActivity.Before(b.LastActivity)
+			}
+		case tidySortDivergence:
+			da, db := a.BaseAhead+a.BaseBehind, b.BaseAhead+b.BaseBehind
+			if da != db {
+				return da > db
+			}
+		case tidySortName:
+		default:
+			if !a.LastActivity.Equal(b.LastActivity) {
+				return a.LastActivity.After(b.LastActivity)
+			}
 		}
-		return ti.After(tj)
+		return a.Worktree.Name < b.Worktree.Name
 	})
 }
 
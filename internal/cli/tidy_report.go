@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/user"
+	"sync"
+	"time"
+)
+
+// cleanupRecord is one line of the wt tidy --report JSON lines log.
+type cleanupRecord struct {
+	Time     time.Time `json:"time"`
+	User     string    `json:"user,omitempty"`
+	Action   string    `json:"action"`
+	Worktree string    `json:"worktree"`
+	Branch   string    `json:"branch,omitempty"`
+	// RemoteBranch is the origin branch a remote action deleted or skipped,
+	// which can differ from Branch (see tidyCandidate.remoteBranchName).
+	RemoteBranch string `json:"remote_branch,omitempty"`
+	Path         string `json:"path,omitempty"`
+	// Commit is the branch tip at deletion, enough to restore it with
+	// `git branch <branch> <commit>`.
+	Commit string `json:"commit,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+const (
+	cleanupActionRemoveWorktree     = "remove_worktree"
+	cleanupActionDeleteBranch       = "delete_branch"
+	cleanupActionDeleteRemoteBranch = "delete_remote_branch"
+	cleanupActionSkipRemoteBranch   = "skip_remote_branch"
+	cleanupActionError              = "error"
+)
+
+// cleanupReport appends cleanupRecords to the --report file. A nil report
+// records nothing, so cleanup code can call it unconditionally. It is safe for
+// the concurrent cleanups of --parallel.
+type cleanupReport struct {
+	mu   sync.Mutex
+	file *os.File
+	enc  *json.Encoder
+	user string
+	err  error
+}
+
+func openCleanupReport(path string) (*cleanupReport, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("open --report file: %w", err)
+	}
+	name := os.Getenv("USER")
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		name = u.Username
+	}
+	return &cleanupReport{file: f, enc: json.NewEncoder(f), user: name}, nil
+}
+
+func (r *cleanupReport) record(rec cleanupRecord) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	rec.Time = currentTimeOverride().UTC()
+	rec.User = r.user
+	if err := r.enc.Encode(rec); err != nil && r.err == nil {
+		r.err = err
+	}
+}
+
+// Close flushes the report and returns the first write error, if any.
+func (r *cleanupReport) Close() error {
+	if r == nil {
+		return nil
+	}
+	closeErr := r.file.Close()
+	if r.err != nil {
+		return fmt.Errorf("write --report file: %w", r.err)
+	}
+	return closeErr
+}
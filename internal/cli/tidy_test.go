@@ -3,10 +3,13 @@ package cli
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -60,6 +63,36 @@ func TestPromptForCandidateShowsRecentCommitsGraph(t *testing.T) {
 	if !strings.Contains(output, "feature polish") {
 		t.Fatalf("expected prompt to include latest commit message, got:\n%s", output)
 	}
+	if got := strings.Count(output, uniqueCommitTag); got != 2 {
+		t.Fatalf("expected both unpushed commits to be marked, got %d:\n%s", got, output)
+	}
+}
+
+func TestPromptForCandidateMarksOnlyCommitsMissingElsewhere(t *testing.T) {
+	disablePromptColors(t)
+
+	repo := t.TempDir()
+	cand := initPromptTestCandidate(t, repo)
+	runGitCmd(t, repo, "update-ref", "refs/remotes/origin/feature/prompts", "HEAD~1")
+
+	reader := bufio.NewReader(strings.NewReader("n\n"))
+	var out bytes.Buffer
+	if _, _, _, err := promptForCandidate(&out, reader, cand, time.Now(), true); err != nil {
+		t.Fatalf("promptForCandidate: %v", err)
+	}
+
+	var marked, unmarked bool
+	for _, line := range strings.Split(out.String(), "\n") {
+		switch {
+		case strings.Contains(line, "feature polish"):
+			marked = strings.Contains(line, uniqueCommitTag)
+		case strings.Contains(line, "feature draft"):
+			unmarked = !strings.Contains(line, uniqueCommitTag)
+		}
+	}
+	if !marked || !unmarked {
+		t.Fatalf("expected only the unpushed commit to be marked:\n%s", out.String())
+	}
 }
 
 func TestPromptForCandidateSkipsCommitGraphWhenNotInteractive(t *testing.T) {
@@ -154,3 +187,383 @@ func writeFile(t *testing.T, path, contents string) {
 		t.Fatalf("writeFile(%s): %v", path, err)
 	}
 }
+
+func TestApplyTidySinceWindowBlocksRecentActivity(t *testing.T) {
+	now := time.Date(2000, time.February, 1, 0, 0, 0, 0, time.UTC)
+	recent := &tidyCandidate{
+		Worktree:     project.Worktree{Name: "recent"},
+		LastActivity: now.Add(-2 * 24 * time.Hour),
+		Stage:        tidyStageScanning,
+	}
+	idle := &tidyCandidate{
+		Worktree:     project.Worktree{Name: "idle"},
+		LastActivity: now.Add(-10 * 24 * time.Hour),
+		Stage:        tidyStageScanning,
+	}
+
+	applyTidySinceWindow([]*tidyCandidate{recent, idle}, now, 7*24*time.Hour)
+
+	if len(recent.BlockReasons) != 1 || recent.BlockReasons[0] != blockReasonSinceWindow {
+		t.Fatalf("expected recent worktree to be blocked, got %v", recent.BlockReasons)
+	}
+	if recent.Stage != tidyStageBlocked {
+		t.Fatalf("recent stage = %q, want %q", recent.Stage, tidyStageBlocked)
+	}
+	if len(idle.BlockReasons) != 0 {
+		t.Fatalf("expected idle worktree to remain eligible, got %v", idle.BlockReasons)
+	}
+}
+
+func TestPerformCleanupsConcurrentlyCollectsErrors(t *testing.T) {
+	repo := initTempRepo(t)
+	proj := &project.Project{Root: repo, DefaultWorktreePath: repo}
+
+	var queue []*tidyCandidate
+	for _, name := range []string{"alpha", "bravo", "charlie"} {
+		path := filepath.Join(repo, name)
+		gitCmd(t, repo, "worktree", "add", "-b", name, path)
+		queue = append(queue, &tidyCandidate{
+			Worktree: project.Worktree{Name: name, Path: path},
+			Branch:   name,
+		})
+	}
+	// A candidate whose branch no longer exists fails without stopping the rest.
+	ghostPath := filepath.Join(repo, "ghost")
+	gitCmd(t, repo, "worktree", "add", "--detach", ghostPath)
+	queue = append(queue, &tidyCandidate{
+		Worktree: project.Worktree{Name: "ghost", Path: ghostPath},
+		Branch:   "ghost",
+	})
+
+	var out bytes.Buffer
+	ui := newTidyUI(&out, queue, time.Now())
+	_, err := performCleanupsConcurrently(context.Background(), &out, nil, proj, queue, 3, ui)
+	if err == nil || !strings.Contains(err.Error(), "ghost") {
+		t.Fatalf("expected ghost failure, got %v", err)
+	}
+	for _, cand := range queue[:3] {
+		if cand.Stage != tidyStageCleaned {
+			t.Fatalf("%s stage = %v, want cleaned", cand.Worktree.Name, cand.Stage)
+		}
+		if _, statErr := os.Stat(cand.Worktree.Path); !os.IsNotExist(statErr) {
+			t.Fatalf("%s still exists", cand.Worktree.Name)
+		}
+	}
+	if queue[3].Stage != tidyStageError {
+		t.Fatalf("ghost stage = %v, want error", queue[3].Stage)
+	}
+}
+
+func TestFormatTidySummaryCountsStages(t *testing.T) {
+	candidates := []*tidyCandidate{
+		{Stage: tidyStageCleaned},
+		{Stage: tidyStageCleaned},
+		{Stage: tidyStageSkipped},
+		{Stage: tidyStageBlocked},
+		{Stage: tidyStageError},
+	}
+	got := formatTidySummary(candidates, 1234*time.Millisecond, true, true)
+	want := "Tidied 2 worktrees, skipped 1, blocked 1, failed 1 in 1.2s; pruned origin"
+	if got != want {
+		t.Fatalf("summary = %q, want %q", got, want)
+	}
+	got = formatTidySummary(candidates[:1], 0, true, false)
+	want = "Tidied 1 worktree, skipped 0, blocked 0 in 0s; prune of origin failed"
+	if got != want {
+		t.Fatalf("summary = %q, want %q", got, want)
+	}
+}
+
+func TestSortCandidatesOrders(t *testing.T) {
+	base := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	cands := []*tidyCandidate{
+		{Worktree: project.Worktree{Name: "bravo"}, LastActivity: base, BaseAhead: 1},
+		{Worktree: project.Worktree{Name: "alpha"}, LastActivity: base.Add(time.Hour), BaseBehind: 7},
+		{Worktree: project.Worktree{Name: "charlie"}, LastActivity: base.Add(-time.Hour), BaseAhead: 2, BaseBehind: 2},
+	}
+	cases := []struct {
+		order tidySortOrder
+		want  string
+	}{
+		{tidySortNewest, "alpha bravo charlie"},
+		{tidySortOldest, "charlie bravo alpha"},
+		{tidySortName, "alpha bravo charlie"},
+		{tidySortDivergence, "alpha charlie bravo"},
+	}
+	for _, tc := range cases {
+		sorted := append([]*tidyCandidate(nil), cands...)
+		sortCandidates(sorted, tc.order)
+		names := make([]string, len(sorted))
+		for i, cand := range sorted {
+			names[i] = cand.Worktree.Name
+		}
+		if got := strings.Join(names, " "); got != tc.want {
+			t.Fatalf("%s order = %q, want %q", tc.order, got, tc.want)
+		}
+	}
+	if _, err := parseTidySortOrder("size"); err == nil {
+		t.Fatalf("expected an invalid --sort value to fail")
+	}
+}
+
+func TestTidyNeedsPrompt(t *testing.T) {
+	cands := []*tidyCandidate{{Classification: tidySafe}, {Classification: tidyGray}, {Classification: tidyBlocked}}
+	if !tidyNeedsPrompt(cands, tidyPolicyAuto) {
+		t.Fatalf("auto policy with gray candidates should prompt")
+	}
+	if tidyNeedsPrompt(cands, tidyPolicyAll) || tidyNeedsPrompt(cands, tidyPolicySafe) {
+		t.Fatalf("all/safe policies never prompt")
+	}
+}
+
+func TestDeriveClassificationNoPRIsSafe(t *testing.T) {
+	newCand := func() *tidyCandidate {
+		return &tidyCandidate{UniqueAhead: 2, TreeMatchesDefault: true, defaultBranch: "main"}
+	}
+
+	cand := newCand()
+	deriveClassification(cand, tidyDeriveContext{})
+	if cand.Classification != tidyGray {
+		t.Fatalf("default classification = %v, want gray", cand.Classification)
+	}
+
+	cand = newCand()
+	deriveClassification(cand, tidyDeriveContext{NoPRIsSafe: true})
+	if cand.Classification != tidySafe {
+		t.Fatalf("with NoPRIsSafe classification = %v (%v), want safe", cand.Classification, cand.GrayReasons)
+	}
+
+	cand = newCand()
+	cand.TreeMatchesDefault = false
+	deriveClassification(cand, tidyDeriveContext{NoPRIsSafe: true})
+	if cand.Classification != tidyGray {
+		t.Fatalf("unmerged branch classification = %v, want gray", cand.Classification)
+	}
+}
+
+func TestRequireRemoteMergeGraysUnmergedHeads(t *testing.T) {
+	repo := initTempRepo(t)
+	gitCmd(t, repo, "update-ref", "refs/remotes/origin/main", "HEAD")
+
+	merged := &tidyCandidate{Worktree: project.Worktree{Name: "merged", Path: repo}}
+	requireRemoteMerge([]*tidyCandidate{merged}, repo, "origin", "main")
+	if len(merged.extraGrayReasons) != 0 {
+		t.Fatalf("merged HEAD grayed: %v", merged.extraGrayReasons)
+	}
+
+	writeFile(t, filepath.Join(repo, "new.txt"), "unmerged")
+	gitCmd(t, repo, "add", "new.txt")
+	gitCmd(t, repo, "commit", "-m", "local only")
+	ahead := &tidyCandidate{Worktree: project.Worktree{Name: "ahead", Path: repo}}
+	requireRemoteMerge([]*tidyCandidate{ahead}, repo, "origin", "main")
+	if want := []string{"not merged into origin/main"}; !reflect.DeepEqual(ahead.extraGrayReasons, want) {
+		t.Fatalf("extraGrayReasons = %v, want %v", ahead.extraGrayReasons, want)
+	}
+
+	missing := &tidyCandidate{Worktree: project.Worktree{Name: "missing", Path: repo}}
+	requireRemoteMerge([]*tidyCandidate{missing}, repo, "upstream", "main")
+	if want := []string{"upstream/main not found"}; !reflect.DeepEqual(missing.extraGrayReasons, want) {
+		t.Fatalf("extraGrayReasons = %v, want %v", missing.extraGrayReasons, want)
+	}
+}
+
+func TestPromptForCandidateShowsDiffThenReprompts(t *testing.T) {
+	repo := t.TempDir()
+	cand := initPromptTestCandidate(t, repo)
+
+	reader := bufio.NewReader(strings.NewReader("d\ny\n"))
+	var out bytes.Buffer
+	ok, quit, lines, err := promptForCandidate(&out, reader, cand, time.Now(), false)
+	if err != nil {
+		t.Fatalf("promptForCandidate: %v", err)
+	}
+	if !ok || quit {
+		t.Fatalf("expected confirmation after diff, got ok=%v quit=%v", ok, quit)
+	}
+	if lines != promptLinesUnknown {
+		t.Fatalf("lines = %d, want promptLinesUnknown", lines)
+	}
+	output := out.String()
+	if !strings.Contains(output, "+second") {
+		t.Fatalf("expected diff output, got:\n%s", output)
+	}
+	if got := strings.Count(output, "Proceed with cleanup?"); got != 2 {
+		t.Fatalf("expected the prompt twice, got %d:\n%s", got, output)
+	}
+}
+
+func TestLinkedDefaultWorktreeProtectsMainCheckout(t *testing.T) {
+	primary := initTempRepo(t)
+	gitCmd(t, primary, "branch", "-M", "main")
+	gitCmd(t, primary, "switch", "--quiet", "-c", "scratch")
+
+	root := t.TempDir()
+	if err := os.Mkdir(filepath.Join(root, ".wt"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	writeFile(t, filepath.Join(root, ".wt", "config.toml"), "default_branch = \"main\"\n")
+	gitCmd(t, primary, "worktree", "add", "--quiet", filepath.Join(root, "main"), "main")
+	gitCmd(t, primary, "worktree", "add", "--quiet", "-b", "feature", filepath.Join(root, "feature"), "main")
+	featurePath := filepath.Join(root, "feature")
+	writeFile(t, filepath.Join(featurePath, "feature.txt"), "work")
+	gitCmd(t, featurePath, "add", "feature.txt")
+	gitCmd(t, featurePath, "commit", "--quiet", "-m", "feature work")
+
+	proj, err := project.Load(root)
+	if err != nil {
+		t.Fatalf("project.Load: %v", err)
+	}
+	worktrees, err := project.ListWorktrees(root)
+	if err != nil {
+		t.Fatalf("ListWorktrees: %v", err)
+	}
+	var mainCheckout, feature *project.Worktree
+	for i := range worktrees {
+		switch worktrees[i].Name {
+		case filepath.Base(primary):
+			mainCheckout = &worktrees[i]
+		case "feature":
+			feature = &worktrees[i]
+		case "main":
+			if worktrees[i].Main {
+				t.Fatalf("linked default worktree marked as the main working tree")
+			}
+		}
+	}
+	if mainCheckout == nil || !mainCheckout.Main || feature == nil || feature.Main {
+		t.Fatalf("unexpected worktrees: %+v", worktrees)
+	}
+
+	if _, err := resolveRmTargets(worktrees, proj, []string{mainCheckout.Name}, root); err == nil || !strings.Contains(err.Error(), blockReasonMainWorkingTree) {
+		t.Fatalf("resolveRmTargets error = %v, want %q", err, blockReasonMainWorkingTree)
+	}
+
+	opts := gatherWorktreeGitDataOptionsStatus
+	opts.BaseRef = "main"
+	status, err := collectWorktreeStatus(context.Background(), proj, *feature, "main", opts)
+	if err != nil {
+		t.Fatalf("collectWorktreeStatus: %v", err)
+	}
+	if status.BaseAhead != 1 || status.BaseBehind != 0 {
+		t.Fatalf("feature vs main = +%d -%d, want +1 -0", status.BaseAhead, status.BaseBehind)
+	}
+}
+
+func TestIntegrationBranchWorktreeIsProtected(t *testing.T) {
+	proj := initTempProject(t, "default_branch = \"main\"\nintegration_branch = \"develop\"\n")
+	mainPath := proj.DefaultWorktreePath
+	devPath := filepath.Join(proj.Root, "dev")
+	gitCmd(t, mainPath, "worktree", "add", "--quiet", "-b", "develop", devPath, "main")
+
+	candidates, err := collectTidyCandidates(context.Background(), proj, mergeTargetComparisonContext(proj, false), time.Now())
+	if err != nil {
+		t.Fatalf("collectTidyCandidates: %v", err)
+	}
+	var dev *tidyCandidate
+	for _, cand := range candidates {
+		if cand.Worktree.Name == "dev" {
+			dev = cand
+		}
+	}
+	if dev == nil {
+		t.Fatalf("no candidate for dev among %d candidates", len(candidates))
+	}
+	want := "on integration branch (develop)"
+	if !reflect.DeepEqual(dev.BlockReasons, []string{want}) {
+		t.Fatalf("block reasons = %q, want [%q]", dev.BlockReasons, want)
+	}
+
+	var warn bytes.Buffer
+	if _, err := performRmCleanup(context.Background(), &warn, nil, proj, dev, true, false); err != nil {
+		t.Fatalf("performRmCleanup: %v", err)
+	}
+	if !strings.Contains(warn.String(), "skipped deleting local branch develop (integration branch)") {
+		t.Fatalf("warnings = %q, want the skipped integration branch", warn.String())
+	}
+	gitCmd(t, mainPath, "rev-parse", "--verify", "--quiet", "refs/heads/develop")
+}
+
+func TestSharedUpstreamIsNotTheRemoteBranch(t *testing.T) {
+	proj := initTempProject(t, "default_branch = \"main\"\n")
+	root, mainPath := proj.Root, proj.DefaultWorktreePath
+	originPath := filepath.Join(t.TempDir(), "origin.git")
+	gitCmd(t, root, "init", "--quiet", "--bare", "-b", "main", originPath)
+	gitCmd(t, mainPath, "remote", "add", "origin", originPath)
+	gitCmd(t, mainPath, "push", "--quiet", "-u", "origin", "main")
+	// This clone creates the shared release branch by pushing it, so its
+	// remote-tracking ref looks just like one a feature push would leave.
+	gitCmd(t, mainPath, "push", "--quiet", "origin", "HEAD:release")
+	gitCmd(t, mainPath, "worktree", "add", "--quiet", "-b", "feat", filepath.Join(root, "feat"), "origin/release")
+	gitCmd(t, mainPath, "worktree", "add", "--quiet", "-b", "local-name", filepath.Join(root, "renamed"), "main")
+	gitCmd(t, mainPath, "config", "--add", "remote.origin.push", "refs/heads/local-name:refs/heads/remote-name")
+	gitCmd(t, filepath.Join(root, "renamed"), "push", "--quiet", "-u", "origin", "local-name:remote-name")
+	// hotfix really does push to release, but feat still tracks it.
+	gitCmd(t, mainPath, "worktree", "add", "--quiet", "-b", "hotfix", filepath.Join(root, "hotfix"), "origin/release")
+	gitCmd(t, mainPath, "config", "--add", "remote.origin.push", "refs/heads/hotfix:refs/heads/release")
+
+	candidates, err := collectTidyCandidates(context.Background(), proj, mergeTargetComparisonContext(proj, false), time.Now())
+	if err != nil {
+		t.Fatalf("collectTidyCandidates: %v", err)
+	}
+	byName := map[string]*tidyCandidate{}
+	for _, cand := range candidates {
+		byName[cand.Worktree.Name] = cand
+	}
+	feat := byName["feat"]
+	if feat == nil {
+		t.Fatalf("no candidate for feat among %d candidates", len(candidates))
+	}
+	if feat.RemoteBranch != "feat" || feat.HasRemoteBranch {
+		t.Fatalf("feat remote branch = %q (exists %v), want feat (absent), not the shared origin/release", feat.RemoteBranch, feat.HasRemoteBranch)
+	}
+	renamed := byName["renamed"]
+	if renamed == nil {
+		t.Fatalf("no candidate for renamed among %d candidates", len(candidates))
+	}
+	if renamed.RemoteBranch != "remote-name" || !renamed.HasRemoteBranch {
+		t.Fatalf("renamed remote branch = %q (exists %v), want remote-name", renamed.RemoteBranch, renamed.HasRemoteBranch)
+	}
+	if !renamed.deletesRemoteBranch() {
+		t.Fatalf("renamed would keep origin/remote-name (tracked by %q)", renamed.RemoteTrackedBy)
+	}
+	hotfix := byName["hotfix"]
+	if hotfix == nil {
+		t.Fatalf("no candidate for hotfix among %d candidates", len(candidates))
+	}
+	if hotfix.RemoteBranch != "release" || hotfix.RemoteTrackedBy != "feat" {
+		t.Fatalf("hotfix remote branch = %q (tracked by %q), want release tracked by feat", hotfix.RemoteBranch, hotfix.RemoteTrackedBy)
+	}
+	if hotfix.deletesRemoteBranch() {
+		t.Fatal("hotfix cleanup would delete origin/release, the upstream of feat")
+	}
+}
+
+func TestPerformCleanupsConcurrentlyWithSeveralWorkers(t *testing.T) {
+	proj := initTempProject(t, "default_branch = \"main\"\n")
+	root, mainPath := proj.Root, proj.DefaultWorktreePath
+
+	var queue []*tidyCandidate
+	for i := 0; i < 6; i++ {
+		name := fmt.Sprintf("done-%d", i)
+		path := filepath.Join(root, name)
+		gitCmd(t, mainPath, "worktree", "add", "--quiet", "-b", name, path, "main")
+		queue = append(queue, &tidyCandidate{Worktree: project.Worktree{Name: name, Path: path}, Branch: name})
+	}
+
+	ui := newTidyUI(io.Discard, queue, time.Now())
+	if _, err := performCleanupsConcurrently(context.Background(), nil, nil, proj, queue, 4, ui); err != nil {
+		t.Fatalf("performCleanupsConcurrently: %v", err)
+	}
+	for _, cand := range queue {
+		if cand.Stage != tidyStageCleaned {
+			t.Fatalf("%s stage = %v, want cleaned", cand.Worktree.Name, cand.Stage)
+		}
+		if _, err := os.Stat(cand.Worktree.Path); !os.IsNotExist(err) {
+			t.Fatalf("%s still exists (stat err %v)", cand.Worktree.Path, err)
+		}
+		cmd := exec.Command("git", "-C", mainPath, "rev-parse", "--verify", "--quiet", "refs/heads/"+cand.Branch)
+		if cmd.Run() == nil {
+			t.Fatalf("branch %s still exists", cand.Branch)
+		}
+	}
+}
@@ -2,7 +2,12 @@ package cli
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"os"
 	"runtime/trace"
+	"strings"
+	"time"
 )
 
 func withTraceRegion[T any](ctx context.Context, name string, fn func() (T, error)) (T, error) {
@@ -21,3 +26,38 @@ func withTraceRegionErr(ctx context.Context, name string, fn func() error) error
 	})
 	return err
 }
+
+// phaseTimer times the coarse phases of a command (git collection, PR fetch,
+// CI fetch). Each phase is also a runtime/trace region, so `wt --trace` sees
+// the same boundaries. Timings are only reported when WT_TRACE is set.
+type phaseTimer struct {
+	enabled bool
+	phases  []phaseTiming
+}
+
+type phaseTiming struct {
+	name    string
+	elapsed time.Duration
+}
+
+func newPhaseTimer() *phaseTimer {
+	v := strings.TrimSpace(os.Getenv("WT_TRACE"))
+	return &phaseTimer{enabled: v != "" && v != "0"}
+}
+
+func (t *phaseTimer) run(ctx context.Context, name string, fn func() error) error {
+	start := time.Now()
+	err := withTraceRegionErr(ctx, name, fn)
+	if t.enabled {
+		t.phases = append(t.phases, phaseTiming{name: name, elapsed: time.Since(start)})
+	}
+	return err
+}
+
+// report prints the recorded timings. It runs once the command is done so
+// the lines don't interleave with live table repaints.
+func (t *phaseTimer) report(w io.Writer) {
+	for _, phase := range t.phases {
+		fmt.Fprintf(w, "wt trace: %-20s %s\n", phase.name, phase.elapsed.Round(time.Millisecond))
+	}
+}
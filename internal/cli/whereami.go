@@ -0,0 +1,107 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/brandonbloom/wt/internal/gitutil"
+	"github.com/brandonbloom/wt/internal/project"
+	"github.com/brandonbloom/wt/internal/shellbridge"
+	"github.com/spf13/cobra"
+)
+
+type whereamiOptions struct {
+	json bool
+}
+
+type whereamiReport struct {
+	Directory       string `json:"directory"`
+	ProjectRoot     string `json:"project_root"`
+	ConfigPath      string `json:"config_path"`
+	DefaultWorktree string `json:"default_worktree"`
+	Worktree        string `json:"worktree,omitempty"`
+	WorktreePath    string `json:"worktree_path,omitempty"`
+	Branch          string `json:"branch,omitempty"`
+	ShellWrapper    bool   `json:"shell_wrapper"`
+}
+
+func newWhereamiCommand() *cobra.Command {
+	opts := &whereamiOptions{}
+	cmd := &cobra.Command{
+		Use:   "whereami",
+		Short: "Show the current project, worktree, and branch",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runWhereami(cmd, opts)
+		},
+	}
+	cmd.Flags().BoolVar(&opts.json, "json", false, "emit machine-readable JSON")
+	return cmd
+}
+
+func runWhereami(cmd *cobra.Command, opts *whereamiOptions) error {
+	wd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	proj, err := loadProjectFromWD()
+	if err != nil {
+		return err
+	}
+	worktrees, err := project.ListWorktrees(proj.Root)
+	if err != nil {
+		return err
+	}
+
+	report := whereamiReport{
+		Directory:       wd,
+		ProjectRoot:     proj.Root,
+		ConfigPath:      proj.ConfigPath,
+		DefaultWorktree: proj.DefaultWorktree,
+		ShellWrapper:    shellbridge.Active(),
+	}
+	if current := findWorktreeContaining(worktrees, wd); current != nil {
+		report.Worktree = current.Name
+		report.WorktreePath = current.Path
+		if branch, err := gitutil.CurrentBranch(current.Path); err == nil {
+			report.Branch = branch
+		}
+	}
+
+	out := cmd.OutOrStdout()
+	if opts.json {
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(report)
+	}
+	printWhereami(out, report)
+	return nil
+}
+
+func printWhereami(w io.Writer, report whereamiReport) {
+	worktree := "(none; not inside a worktree)"
+	if report.Worktree != "" {
+		worktree = report.Worktree
+		if report.Worktree == report.DefaultWorktree {
+			worktree += " (default)"
+		}
+	}
+	branch := report.Branch
+	switch branch {
+	case "":
+		branch = "-"
+	case "HEAD":
+		branch = "(detached)"
+	}
+	wrapper := "inactive"
+	if report.ShellWrapper {
+		wrapper = "active"
+	}
+	fmt.Fprintf(w, "project:  %s\n", report.ProjectRoot)
+	fmt.Fprintf(w, "config:   %s\n", report.ConfigPath)
+	fmt.Fprintf(w, "worktree: %s\n", worktree)
+	fmt.Fprintf(w, "branch:   %s\n", branch)
+	fmt.Fprintf(w, "wrapper:  %s\n", wrapper)
+}
@@ -1,10 +1,47 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
+	"io/fs"
+	"os"
 	"strings"
+
+	"github.com/brandonbloom/wt/internal/project"
 )
 
+// missingWorktreeLabel replaces the status details of a worktree whose
+// directory was deleted while git still has it registered.
+const missingWorktreeLabel = "(missing, run git worktree prune)"
+
+// missingWorktreeStatus renders a registered worktree whose directory is gone
+// as an error row instead of letting git fail on the nonexistent path. Its
+// Timestamp stays zero: there is no activity to report, and the row renders
+// "-" rather than sorting as the newest worktree.
+func missingWorktreeStatus(wt project.Worktree, current bool) *worktreeStatus {
+	branch := wt.Branch
+	if branch == "" {
+		branch = wt.Name
+	}
+	return &worktreeStatus{
+		Name:     wt.Name,
+		Path:     wt.Path,
+		Branch:   branch,
+		PRStatus: missingWorktreeLabel,
+		Error:    "directory missing; run `git worktree prune` in your main worktree",
+		HasError: true,
+		Locked:   wt.Locked,
+		Current:  current,
+	}
+}
+
+// pathExists reports whether path is known to exist; errors other than
+// nonexistence count as existing so the caller surfaces them itself.
+func pathExists(path string) bool {
+	_, err := os.Stat(path)
+	return !errors.Is(err, fs.ErrNotExist)
+}
+
 func friendlyWorktreeGitError(worktreeName string, err error) (string, bool) {
 	if err == nil {
 		return "", false
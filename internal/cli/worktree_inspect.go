@@ -12,22 +12,46 @@ import (
 )
 
 type worktreeGitData struct {
-	Worktree           project.Worktree
-	Branch             string
-	Dirty              bool
-	HasStash           bool
-	Operation          string
-	Ahead              int
-	Behind             int
-	BaseAhead          int
-	BaseBehind         int
-	Timestamp          time.Time
-	UniqueAhead        int
-	HeadHash           string
+	Worktree       project.Worktree
+	Branch         string
+	Dirty          bool
+	SubmodulesOnly bool
+	HasStash       bool
+	Operation      string
+	Ahead          int
+	Behind         int
+	BaseAhead      int
+	BaseBehind     int
+	Timestamp      time.Time
+	UniqueAhead    int
+	HeadHash       string
+	// Unborn is set when the branch has no commits yet; the commit-derived
+	// fields are left zero.
+	Unborn       bool
+	UpstreamGone bool
+	// Unpushed is set when the branch has no upstream and no origin/<branch>;
+	// only computed with IncludePushState.
+	Unpushed bool
+	Subject  string
+	// RemoteBranch is the branch's name on origin, which differs from Branch
+	// when it was pushed as local:other; only set with IncludeRemoteInfo.
+	RemoteBranch string
+	// RemoteTrackedBy names another local branch whose upstream is
+	// origin/RemoteBranch; cleanup leaves such a remote branch alone.
+	RemoteTrackedBy    string
 	HasRemoteBranch    bool
 	RemoteMatchesHead  bool
 	MergedIntoDefault  bool
 	TreeMatchesDefault bool
+	// PartialErrors lists the fields that could not be loaded when
+	// AllowPartial is set; those fields keep their zero values.
+	PartialErrors []gitFieldError
+}
+
+// gitFieldError is a failure to load one piece of a worktree's git data.
+type gitFieldError struct {
+	Field string
+	Err   error
 }
 
 type gatherWorktreeGitDataOptions struct {
@@ -35,13 +59,31 @@ type gatherWorktreeGitDataOptions struct {
 	IncludeMergeState    bool
 	IncludeTreeMatch     bool
 	IncludeRemoteInfo    bool
-	StashBranches        map[string]bool
+	// IncludeSubmodules forces submodule modifications to count as dirty
+	// regardless of the repository's ignore settings.
+	IncludeSubmodules bool
+	// IncludeSubject loads the HEAD commit subject for `wt status --show-subject`.
+	IncludeSubject bool
+	// BaseRef overrides origin/<default> as the base for BaseAhead/BaseBehind
+	// (`wt status --base`).
+	BaseRef string
+	// TargetBranch replaces default_branch as the branch whose origin copy
+	// BaseAhead/BaseBehind are measured against (integration_branch).
+	TargetBranch  string
+	StashBranches map[string]bool
+	// IncludePushState fills Unpushed. Callers enable it only when
+	// origin/<default> exists, so unfetched or remote-less projects stay quiet.
+	IncludePushState bool
+	// AllowPartial records failures after `git status` in PartialErrors
+	// instead of failing the whole worktree. Only display-only callers (wt
+	// status) set it; tidy must not decide safety from incomplete data.
+	AllowPartial bool
 }
 
 var gatherWorktreeGitDataOptionsStatus = gatherWorktreeGitDataOptions{
 	IncludeUniqueCommits: true,
 	IncludeMergeState:    false,
-	IncludeTreeMatch:     false,
+	IncludeTreeMatch:     true,
 	IncludeRemoteInfo:    false,
 }
 
@@ -54,8 +96,20 @@ var gatherWorktreeGitDataOptionsFull = gatherWorktreeGitDataOptions{
 
 func gatherWorktreeGitData(ctx context.Context, proj *project.Project, wt project.Worktree, defaultCompareRef string, opts gatherWorktreeGitDataOptions) (*worktreeGitData, error) {
 	data := &worktreeGitData{Worktree: wt}
+	// fail either records err against field and lets gathering continue
+	// (nil), or hands it back to abort.
+	fail := func(field string, err error) error {
+		if !opts.AllowPartial {
+			return err
+		}
+		data.PartialErrors = append(data.PartialErrors, gitFieldError{Field: field, Err: err})
+		return nil
+	}
 
 	status, err := withTraceRegion(ctx, "git status", func() (gitutil.StatusSummary, error) {
+		if opts.IncludeSubmodules {
+			return gitutil.StatusIncludingSubmodules(wt.Path)
+		}
 		return gitutil.Status(wt.Path)
 	})
 	if err != nil {
@@ -65,6 +119,23 @@ func gatherWorktreeGitData(ctx context.Context, proj *project.Project, wt projec
 	data.HeadHash = status.HeadOID
 
 	data.Dirty = status.HasChanges
+	data.SubmodulesOnly = status.SubmodulesOnly
+
+	// Porcelain reports "(initial)" for an unborn branch; confirm before
+	// skipping everything that needs a HEAD commit.
+	if status.HeadOID == "" || status.HeadOID == "(initial)" {
+		exists, err := withTraceRegion(ctx, "git verify head", func() (bool, error) {
+			return gitutil.HeadExists(wt.Path)
+		})
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			data.Unborn = true
+			data.HeadHash = ""
+			return data, nil
+		}
+	}
 
 	if data.Branch != "" {
 		stash, err := withTraceRegion(ctx, "git stash", func() (bool, error) {
@@ -74,7 +145,9 @@ func gatherWorktreeGitData(ctx context.Context, proj *project.Project, wt projec
 			return gitutil.HasBranchStash(wt.Path, data.Branch)
 		})
 		if err != nil {
-			return nil, err
+			if err := fail("stash", err); err != nil {
+				return nil, err
+			}
 		}
 		data.HasStash = stash
 	}
@@ -86,12 +159,28 @@ func gatherWorktreeGitData(ctx context.Context, proj *project.Project, wt projec
 
 	data.Ahead = status.Ahead
 	data.Behind = status.Behind
+	data.UpstreamGone = status.UpstreamGone
+	if opts.IncludePushState && status.Upstream == "" && data.Branch != "" && data.Branch != "HEAD" {
+		exists, err := withTraceRegion(ctx, "git remote branch", func() (bool, error) {
+			_, exists, err := gitutil.RemoteBranchHead(wt.Path, "origin", data.Branch)
+			return exists, err
+		})
+		if err != nil {
+			if err := fail("push state", err); err != nil {
+				return nil, err
+			}
+		} else {
+			data.Unpushed = !exists
+		}
+	}
 
 	ts, err := withTraceRegion(ctx, "git head timestamp", func() (time.Time, error) {
 		return gitutil.HeadTimestamp(wt.Path)
 	})
 	if err != nil {
-		return nil, err
+		if err := fail("head timestamp", err); err != nil {
+			return nil, err
+		}
 	}
 	if data.Dirty {
 		dirtyTS, derr := withTraceRegion(ctx, "dirty mtime", func() (time.Time, error) {
@@ -103,19 +192,41 @@ func gatherWorktreeGitData(ctx context.Context, proj *project.Project, wt projec
 	}
 	data.Timestamp = ts
 
+	if opts.IncludeSubject {
+		subject, err := withTraceRegion(ctx, "git head subject", func() (string, error) {
+			return gitutil.HeadSubject(wt.Path)
+		})
+		if err != nil {
+			if err := fail("subject", err); err != nil {
+				return nil, err
+			}
+		}
+		data.Subject = subject
+	}
+
 	baseAhead, baseBehind, err := func() (int, int, error) {
 		type aheadBehind struct {
 			ahead  int
 			behind int
 		}
 		out, err := withTraceRegion(ctx, "git ahead/behind default", func() (aheadBehind, error) {
-			ahead, behind, err := gitutil.AheadBehindDefaultBranch(wt.Path, proj.Config.DefaultBranch)
+			if opts.BaseRef != "" {
+				ahead, behind, err := gitutil.AheadBehindRef(wt.Path, opts.BaseRef)
+				return aheadBehind{ahead: ahead, behind: behind}, err
+			}
+			target := proj.Config.DefaultBranch
+			if opts.TargetBranch != "" {
+				target = opts.TargetBranch
+			}
+			ahead, behind, err := gitutil.AheadBehindDefaultBranch(wt.Path, target)
 			return aheadBehind{ahead: ahead, behind: behind}, err
 		})
 		return out.ahead, out.behind, err
 	}()
 	if err != nil {
-		return nil, err
+		if err := fail("ahead/behind base", err); err != nil {
+			return nil, err
+		}
 	}
 	data.BaseAhead = baseAhead
 	data.BaseBehind = baseBehind
@@ -130,7 +241,9 @@ func gatherWorktreeGitData(ctx context.Context, proj *project.Project, wt projec
 			return gitutil.HeadMergedInto(wt.Path, compareRef)
 		})
 		if err != nil {
-			return nil, err
+			if err := fail("merge state", err); err != nil {
+				return nil, err
+			}
 		}
 		data.MergedIntoDefault = merged
 	}
@@ -140,7 +253,9 @@ func gatherWorktreeGitData(ctx context.Context, proj *project.Project, wt projec
 			return gitutil.HeadSameTree(wt.Path, compareRef)
 		})
 		if err != nil {
-			return nil, err
+			if err := fail("tree match", err); err != nil {
+				return nil, err
+			}
 		}
 		data.TreeMatchesDefault = treeMatches
 	}
@@ -150,29 +265,46 @@ func gatherWorktreeGitData(ctx context.Context, proj *project.Project, wt projec
 			return gitutil.UniqueCommitsComparedTo(wt.Path, compareRef)
 		})
 		if err != nil {
-			return nil, err
+			if err := fail("unique commits", err); err != nil {
+				return nil, err
+			}
 		}
 		data.UniqueAhead = uniqueAhead
 	}
 
 	if opts.IncludeRemoteInfo && proj.DefaultWorktreePath != "" {
+		data.RemoteBranch = gitutil.RemoteBranchName(proj.DefaultWorktreePath, "origin", data.Branch)
+		// Branches started from origin/<default> track it; that upstream is
+		// where the work lands, not where it was pushed.
+		if data.RemoteBranch == proj.Config.DefaultBranch || data.RemoteBranch == proj.Config.MergeTarget() {
+			data.RemoteBranch = data.Branch
+		}
 		remoteHash, exists, err := func() (string, bool, error) {
 			type remoteBranch struct {
 				hash   string
 				exists bool
 			}
 			out, err := withTraceRegion(ctx, "git remote branch head", func() (remoteBranch, error) {
-				hash, exists, err := gitutil.RemoteBranchHead(proj.DefaultWorktreePath, "origin", data.Branch)
+				hash, exists, err := gitutil.RemoteBranchHead(proj.DefaultWorktreePath, "origin", data.RemoteBranch)
 				return remoteBranch{hash: hash, exists: exists}, err
 			})
 			return out.hash, out.exists, err
 		}()
 		if err != nil {
-			return nil, err
+			if err := fail("remote branch", err); err != nil {
+				return nil, err
+			}
 		}
 		data.HasRemoteBranch = exists
 		if exists {
 			data.RemoteMatchesHead = remoteHash == data.HeadHash
+			tracker, err := gitutil.RemoteBranchTrackedBy(proj.DefaultWorktreePath, "origin", data.RemoteBranch, data.Branch)
+			if err != nil {
+				if err := fail("remote branch", err); err != nil {
+					return nil, err
+				}
+			}
+			data.RemoteTrackedBy = tracker
 		}
 	}
 
@@ -33,6 +33,29 @@ func resolveWorktreeArgs(worktrees []project.Worktree, args []string, wd string)
 	return targets, nil
 }
 
+// selectWorktreesByName restricts worktrees to the named ones, in the order
+// given, rejecting unknown names. Unlike resolveWorktreeArgs it does not
+// accept paths.
+func selectWorktreesByName(worktrees []project.Worktree, names []string) ([]project.Worktree, error) {
+	seen := make(map[string]bool, len(names))
+	selected := make([]project.Worktree, 0, len(names))
+	for _, name := range names {
+		wt := findWorktreeByName(worktrees, name)
+		if wt == nil {
+			return nil, fmt.Errorf("no worktree named %s", name)
+		}
+		if seen[wt.Name] {
+			continue
+		}
+		seen[wt.Name] = true
+		selected = append(selected, *wt)
+	}
+	return selected, nil
+}
+
+// findWorktreeByName matches a worktree's directory name, falling back to the
+// branch it has checked out so slash-containing branches like feature/login
+// resolve to their feature-login directory.
 func findWorktreeByName(worktrees []project.Worktree, name string) *project.Worktree {
 	for _, wt := range worktrees {
 		if wt.Name == name {
@@ -40,6 +63,12 @@ func findWorktreeByName(worktrees []project.Worktree, name string) *project.Work
 			return &copy
 		}
 	}
+	for _, wt := range worktrees {
+		if wt.Branch != "" && wt.Branch == name {
+			copy := wt
+			return &copy
+		}
+	}
 	return nil
 }
 
@@ -4,27 +4,112 @@ import (
 	"errors"
 	"fmt"
 	"io/fs"
+	"maps"
 	"os"
 	"path/filepath"
+	"regexp"
+	"slices"
 	"strings"
 	"time"
+	"unicode"
 
 	toml "github.com/pelletier/go-toml/v2"
 )
 
 // Config captures the user editable settings stored in .wt/config.toml.
 type Config struct {
-	DefaultBranch string         `toml:"default_branch"`
-	Bootstrap     BootstrapBlock `toml:"bootstrap"`
-	Tidy          TidyBlock      `toml:"tidy"`
-	Process       ProcessBlock   `toml:"process"`
-	CI            CIBlock        `toml:"ci"`
+	DefaultBranch string `toml:"default_branch"`
+	// IntegrationBranch is where feature branches merge (e.g. develop) when
+	// that differs from the release branch in DefaultBranch.
+	IntegrationBranch string         `toml:"integration_branch,omitempty"`
+	Bootstrap         BootstrapBlock `toml:"bootstrap"`
+	Tidy              TidyBlock      `toml:"tidy"`
+	Process           ProcessBlock   `toml:"process"`
+	CI                CIBlock        `toml:"ci"`
+	GH                GHBlock        `toml:"gh"`
+	Status            StatusBlock    `toml:"status"`
+	New               NewBlock       `toml:"new"`
+	// Aliases maps a shortcut to the wt arguments it expands to, e.g.
+	// s = "switch".
+	Aliases map[string]string `toml:"aliases,omitempty"`
 }
 
 // BootstrapBlock describes commands that run after creating a new worktree.
 type BootstrapBlock struct {
-	Run    string `toml:"run"`
-	Strict *bool  `toml:"strict"`
+	Run     string            `toml:"run"`
+	Strict  *bool             `toml:"strict"`
+	Timeout string            `toml:"timeout"`
+	Env     map[string]string `toml:"env"`
+}
+
+var envNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+func (b BootstrapBlock) Validate() error {
+	for key := range b.Env {
+		if !envNamePattern.MatchString(key) {
+			return fmt.Errorf("%w (got %q)", ErrInvalidBootstrapEnv, key)
+		}
+	}
+	if strings.TrimSpace(b.Timeout) == "" {
+		return nil
+	}
+	d, err := time.ParseDuration(b.Timeout)
+	if err != nil || d <= 0 {
+		return ErrInvalidBootstrapTimeout
+	}
+	return nil
+}
+
+// TimeoutDuration returns the configured bootstrap deadline, or zero when the
+// script may run indefinitely.
+func (b BootstrapBlock) TimeoutDuration() time.Duration {
+	d, err := time.ParseDuration(strings.TrimSpace(b.Timeout))
+	if err != nil || d <= 0 {
+		return 0
+	}
+	return d
+}
+
+// NewBlock governs wt new behavior.
+type NewBlock struct {
+	CopyFiles []string          `toml:"copy_files"`
+	BaseRules map[string]string `toml:"base_rules"`
+}
+
+func (n NewBlock) Validate() error {
+	for pattern, base := range n.BaseRules {
+		if _, err := filepath.Match(pattern, ""); err != nil || strings.TrimSpace(base) == "" {
+			return fmt.Errorf("%w (got %q = %q)", ErrInvalidBaseRule, pattern, base)
+		}
+	}
+	for _, rel := range n.CopyFiles {
+		clean := filepath.Clean(strings.TrimSpace(rel))
+		if clean == "." || filepath.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, ".."+string(filepath.Separator)) {
+			return fmt.Errorf("%w (got %q)", ErrInvalidCopyFile, rel)
+		}
+	}
+	return nil
+}
+
+// BaseForName returns the base branch configured for a new worktree name via
+// base_rules. When several globs match, the longest (most specific) pattern
+// wins, with ties broken alphabetically so the choice is deterministic.
+func (n NewBlock) BaseForName(name string) (string, bool) {
+	best := ""
+	found := false
+	for pattern := range n.BaseRules {
+		if ok, _ := filepath.Match(pattern, name); !ok {
+			continue
+		}
+		if !found || len(pattern) > len(best) || (len(pattern) == len(best) && pattern < best) {
+			best = pattern
+			found = true
+		}
+	}
+	if !found {
+		return "", false
+	}
+	return strings.TrimSpace(n.BaseRules[best]), true
 }
 
 // TidyBlock governs wt tidy behavior.
@@ -32,6 +117,10 @@ type TidyBlock struct {
 	Policy            string `toml:"policy"`
 	StaleDays         int    `toml:"stale_days"`
 	DivergenceCommits int    `toml:"divergence_commits"`
+	NoPRIsSafe        bool   `toml:"no_pr_is_safe"`
+	// RemotePrune runs `git remote prune origin` after remote branches are
+	// deleted. Nil means true.
+	RemotePrune *bool `toml:"remote_prune"`
 }
 
 func (t *TidyBlock) applyDefaults() {
@@ -63,6 +152,9 @@ func (t TidyBlock) Validate() error {
 // ProcessBlock configures process handling behavior.
 type ProcessBlock struct {
 	KillTimeout string `toml:"kill_timeout"`
+	// EscalateSignal is sent to processes that outlive KillTimeout when
+	// --escalate is given. Empty means KILL.
+	EscalateSignal string `toml:"escalate_signal,omitempty"`
 }
 
 func (p *ProcessBlock) applyDefaults() {
@@ -94,9 +186,12 @@ func (p ProcessBlock) KillTimeoutDuration() time.Duration {
 	return d
 }
 
-// CIBlock configures how wt discovers GitHub CI metadata.
+// CIBlock configures how wt discovers CI metadata. Command, when set,
+// replaces the GitHub lookup: it runs in each worktree and its exit code
+// (0 success, 2 pending, anything else failure) becomes the CI state.
 type CIBlock struct {
-	Remote string `toml:"remote"`
+	Remote  string `toml:"remote"`
+	Command string `toml:"command"`
 }
 
 func (c *CIBlock) applyDefaults() {
@@ -107,6 +202,7 @@ func (c *CIBlock) applyDefaults() {
 	if c.Remote == "" {
 		c.Remote = "origin"
 	}
+	c.Command = strings.TrimSpace(c.Command)
 }
 
 // RemoteName returns the configured remote, defaulting to "origin".
@@ -117,11 +213,148 @@ func (c CIBlock) RemoteName() string {
 	return c.Remote
 }
 
+// GHBlock configures how wt invokes the gh CLI.
+type GHBlock struct {
+	Timeout string `toml:"timeout"`
+	PRLimit int    `toml:"pr_limit"`
+	// Enabled allows wt to run gh at all. Nil means true; WT_NO_GH overrides
+	// it either way.
+	Enabled *bool `toml:"enabled"`
+}
+
+// IsEnabled reports whether the config allows gh invocations.
+func (g GHBlock) IsEnabled() bool {
+	if g.Enabled == nil {
+		return true
+	}
+	return *g.Enabled
+}
+
+// maxPRLimit mirrors GitHub's GraphQL page size cap.
+const maxPRLimit = 100
+
+func (g *GHBlock) applyDefaults() {
+	if g == nil {
+		return
+	}
+	if strings.TrimSpace(g.Timeout) == "" {
+		g.Timeout = "15s"
+	}
+	if g.PRLimit <= 0 {
+		g.PRLimit = 5
+	}
+}
+
+func (g GHBlock) Validate() error {
+	if g.PRLimit > maxPRLimit {
+		return ErrInvalidGHPRLimit
+	}
+	if strings.TrimSpace(g.Timeout) == "" {
+		return nil
+	}
+	d, err := time.ParseDuration(g.Timeout)
+	if err != nil || d <= 0 {
+		return ErrInvalidGHTimeout
+	}
+	return nil
+}
+
+// TimeoutDuration returns how long a batch of gh calls may run before wt gives up.
+func (g GHBlock) TimeoutDuration() time.Duration {
+	d, err := time.ParseDuration(g.Timeout)
+	if err != nil || d <= 0 {
+		return 15 * time.Second
+	}
+	return d
+}
+
+// StatusBlock configures the wt status dashboard.
+type StatusBlock struct {
+	// FetchWarnAge is how old the last fetch may get before wt status hints
+	// that ahead/behind counts may be stale. "0" disables the hint.
+	FetchWarnAge string `toml:"fetch_warn_age"`
+	// ShowProcesses runs process detection for the process summary. Nil
+	// means true.
+	ShowProcesses *bool `toml:"show_processes"`
+}
+
+// ShowProcessesEnabled reports whether wt status should detect processes.
+func (s StatusBlock) ShowProcessesEnabled() bool {
+	if s.ShowProcesses == nil {
+		return true
+	}
+	return *s.ShowProcesses
+}
+
+func (s *StatusBlock) applyDefaults() {
+	if s == nil {
+		return
+	}
+	if strings.TrimSpace(s.FetchWarnAge) == "" {
+		s.FetchWarnAge = "1h"
+	}
+}
+
+func (s StatusBlock) Validate() error {
+	if strings.TrimSpace(s.FetchWarnAge) == "" {
+		return nil
+	}
+	d, err := time.ParseDuration(s.FetchWarnAge)
+	if err != nil || d < 0 {
+		return ErrInvalidStatusFetchWarnAge
+	}
+	return nil
+}
+
+// FetchWarnAgeDuration returns the stale-fetch threshold, zero when disabled.
+func (s StatusBlock) FetchWarnAgeDuration() time.Duration {
+	d, err := time.ParseDuration(s.FetchWarnAge)
+	if err != nil || d < 0 {
+		return time.Hour
+	}
+	return d
+}
+
+var aliasNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]*$`)
+
+func validAlias(name, expansion string) bool {
+	fields := strings.Fields(expansion)
+	return aliasNamePattern.MatchString(name) && len(fields) > 0 && !strings.HasPrefix(fields[0], "-")
+}
+
+// AliasArgs returns the arguments a valid [aliases] entry expands to. The
+// expansion is split on whitespace; quoting is not supported.
+func (c Config) AliasArgs(name string) ([]string, bool) {
+	expansion, ok := c.Aliases[name]
+	if !ok || !validAlias(name, expansion) {
+		return nil, false
+	}
+	return strings.Fields(expansion), true
+}
+
+// MergeTarget returns the branch feature work merges into: IntegrationBranch
+// when set, otherwise DefaultBranch.
+func (c Config) MergeTarget() string {
+	if c.IntegrationBranch != "" {
+		return c.IntegrationBranch
+	}
+	return c.DefaultBranch
+}
+
 // CIRemote returns the configured remote for CI metadata.
 func (c Config) CIRemote() string {
 	return c.CI.RemoteName()
 }
 
+// RemotePruneEnabled reports whether tidy should prune origin after deleting
+// remote branches.
+func (t TidyBlock) RemotePruneEnabled() bool {
+	if t.RemotePrune == nil {
+		return true
+	}
+	return *t.RemotePrune
+}
+
 // StrictEnabled reports whether strict shell options should be enabled.
 func (b BootstrapBlock) StrictEnabled() bool {
 	if b.Strict == nil {
@@ -133,10 +366,28 @@ func (b BootstrapBlock) StrictEnabled() bool {
 var (
 	// ErrMissingDefaultBranch indicates the config omitted the required branch.
 	ErrMissingDefaultBranch = errors.New("config.default_branch must be set")
+	// ErrInvalidIntegrationBranch indicates integration_branch is not a plausible branch name.
+	ErrInvalidIntegrationBranch = errors.New("config.integration_branch must be a branch name")
 	// ErrInvalidTidyPolicy indicates the tidy policy is not recognized.
 	ErrInvalidTidyPolicy = errors.New("config.tidy.policy must be auto, safe, all, or prompt")
 	// ErrInvalidProcessTimeout indicates the process kill timeout is invalid.
 	ErrInvalidProcessTimeout = errors.New("config.process.kill_timeout must be a positive duration (e.g. 3s)")
+	// ErrInvalidBootstrapTimeout indicates the bootstrap timeout is invalid.
+	ErrInvalidBootstrapTimeout = errors.New("config.bootstrap.timeout must be a positive duration (e.g. 10m)")
+	// ErrInvalidBootstrapEnv indicates a bootstrap env key is not a valid identifier.
+	ErrInvalidBootstrapEnv = errors.New("config.bootstrap.env keys must be valid environment variable names")
+	// ErrInvalidCopyFile indicates a new.copy_files entry escapes the worktree.
+	ErrInvalidCopyFile = errors.New("config.new.copy_files entries must be relative paths inside the worktree")
+	// ErrInvalidBaseRule indicates a new.base_rules entry has a bad glob or empty base.
+	ErrInvalidBaseRule = errors.New("config.new.base_rules entries must map a valid glob to a branch name")
+	// ErrInvalidGHTimeout indicates the gh timeout is invalid.
+	ErrInvalidGHTimeout = errors.New("config.gh.timeout must be a positive duration (e.g. 15s)")
+	// ErrInvalidGHPRLimit indicates the per-branch PR limit exceeds GitHub's page size.
+	ErrInvalidGHPRLimit = errors.New("config.gh.pr_limit must be at most 100")
+	// ErrInvalidStatusFetchWarnAge indicates the stale-fetch threshold is invalid.
+	ErrInvalidStatusFetchWarnAge = errors.New("config.status.fetch_warn_age must be a duration (e.g. 1h, or 0 to disable)")
+	// ErrInvalidAlias indicates an aliases entry has a bad name or an empty expansion.
+	ErrInvalidAlias = errors.New("config.aliases entries must map a name (letters, digits, - and _) to a wt subcommand")
 )
 
 // Default returns a baseline configuration for a project.
@@ -150,6 +401,7 @@ func Default(defaultBranch string) Config {
 		Bootstrap:     BootstrapBlock{},
 		Process:       ProcessBlock{},
 		CI:            CIBlock{},
+		GH:            GHBlock{},
 	}
 	cfg.applyDefaults()
 	return cfg
@@ -159,24 +411,137 @@ func (c *Config) applyDefaults() {
 	c.Tidy.applyDefaults()
 	c.Process.applyDefaults()
 	c.CI.applyDefaults()
+	c.GH.applyDefaults()
+	c.Status.applyDefaults()
 }
 
 // Validate ensures the configuration can guide wt's behavior.
 func (c Config) Validate() error {
+	if problems := c.Problems(); len(problems) > 0 {
+		return problems[0]
+	}
+	return nil
+}
+
+// Problems reports every section that fails validation, rather than stopping
+// at the first like Validate, so wt doctor can list them all.
+func (c Config) Problems() []error {
+	var problems []error
 	if c.DefaultBranch == "" {
-		return ErrMissingDefaultBranch
+		problems = append(problems, ErrMissingDefaultBranch)
 	}
-	if err := c.Tidy.Validate(); err != nil {
-		return err
+	if !validIntegrationBranch(c.IntegrationBranch) {
+		problems = append(problems, ErrInvalidIntegrationBranch)
 	}
-	if err := c.Process.Validate(); err != nil {
-		return err
+	for _, err := range []error{
+		c.Bootstrap.Validate(),
+		c.Tidy.Validate(),
+		c.Process.Validate(),
+		c.GH.Validate(),
+		c.New.Validate(),
+		c.Status.Validate(),
+	} {
+		if err != nil {
+			problems = append(problems, err)
+		}
 	}
-	return nil
+	for _, name := range slices.Sorted(maps.Keys(c.Aliases)) {
+		if !validAlias(name, c.Aliases[name]) {
+			problems = append(problems, fmt.Errorf("%w (got %q)", ErrInvalidAlias, name))
+			break
+		}
+	}
+	return problems
+}
+
+func validIntegrationBranch(branch string) bool {
+	return branch == "" || (!strings.ContainsFunc(branch, unicode.IsSpace) && !strings.HasPrefix(branch, "-"))
+}
+
+// Repair resets invalid settings to their defaults (dropping invalid list and
+// map entries) and describes each change. defaultBranch fills in a missing
+// default_branch.
+func (c *Config) Repair(defaultBranch string) []string {
+	var changes []string
+	defaults := Default(defaultBranch)
+	if c.DefaultBranch == "" && defaultBranch != "" {
+		c.DefaultBranch = defaultBranch
+		changes = append(changes, fmt.Sprintf("default_branch set to %q", defaultBranch))
+	}
+	if !validIntegrationBranch(c.IntegrationBranch) {
+		c.IntegrationBranch = ""
+		changes = append(changes, "integration_branch cleared")
+	}
+	for key := range c.Bootstrap.Env {
+		if !envNamePattern.MatchString(key) {
+			delete(c.Bootstrap.Env, key)
+			changes = append(changes, fmt.Sprintf("bootstrap.env dropped %q", key))
+		}
+	}
+	if (BootstrapBlock{Timeout: c.Bootstrap.Timeout}).Validate() != nil {
+		c.Bootstrap.Timeout = ""
+		changes = append(changes, "bootstrap.timeout cleared")
+	}
+	if (TidyBlock{Policy: c.Tidy.Policy}).Validate() != nil {
+		c.Tidy.Policy = defaults.Tidy.Policy
+		changes = append(changes, "tidy.policy reset to "+c.Tidy.Policy)
+	}
+	if (ProcessBlock{KillTimeout: c.Process.KillTimeout}).Validate() != nil {
+		c.Process.KillTimeout = defaults.Process.KillTimeout
+		changes = append(changes, "process.kill_timeout reset to "+c.Process.KillTimeout)
+	}
+	if (GHBlock{PRLimit: c.GH.PRLimit}).Validate() != nil {
+		c.GH.PRLimit = defaults.GH.PRLimit
+		changes = append(changes, fmt.Sprintf("gh.pr_limit reset to %d", c.GH.PRLimit))
+	}
+	if (GHBlock{Timeout: c.GH.Timeout}).Validate() != nil {
+		c.GH.Timeout = defaults.GH.Timeout
+		changes = append(changes, "gh.timeout reset to "+c.GH.Timeout)
+	}
+	if (StatusBlock{FetchWarnAge: c.Status.FetchWarnAge}).Validate() != nil {
+		c.Status.FetchWarnAge = defaults.Status.FetchWarnAge
+		changes = append(changes, "status.fetch_warn_age reset to "+c.Status.FetchWarnAge)
+	}
+	var copyFiles []string
+	for _, rel := range c.New.CopyFiles {
+		if (NewBlock{CopyFiles: []string{rel}}).Validate() != nil {
+			changes = append(changes, fmt.Sprintf("new.copy_files dropped %q", rel))
+			continue
+		}
+		copyFiles = append(copyFiles, rel)
+	}
+	c.New.CopyFiles = copyFiles
+	for pattern, base := range c.New.BaseRules {
+		if (NewBlock{BaseRules: map[string]string{pattern: base}}).Validate() != nil {
+			delete(c.New.BaseRules, pattern)
+			changes = append(changes, fmt.Sprintf("new.base_rules dropped %q", pattern))
+		}
+	}
+	for name, expansion := range c.Aliases {
+		if !validAlias(name, expansion) {
+			delete(c.Aliases, name)
+			changes = append(changes, fmt.Sprintf("aliases dropped %q", name))
+		}
+	}
+	c.applyDefaults()
+	return changes
 }
 
 // Load reads configuration from disk. Missing files return a default config.
 func Load(path string) (Config, error) {
+	cfg, err := Read(path)
+	if err != nil {
+		return Config{}, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return Config{}, err
+	}
+	return cfg, nil
+}
+
+// Read is Load without validation, for diagnosing and repairing a broken
+// config.
+func Read(path string) (Config, error) {
 	data, err := os.ReadFile(path)
 	if err != nil {
 		if errors.Is(err, fs.ErrNotExist) {
@@ -190,10 +555,6 @@ func Load(path string) (Config, error) {
 		return Config{}, fmt.Errorf("parse %s: %w", path, err)
 	}
 	cfg.applyDefaults()
-	if err := cfg.Validate(); err != nil {
-		return Config{}, err
-	}
-
 	return cfg, nil
 }
 
@@ -0,0 +1,84 @@
+package forge
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// azureForge lists pull requests with the Azure CLI (`az repos pr list`,
+// from the azure-devops extension). CI (Azure Pipelines) is not wired up
+// yet, so ListCIRuns reports nothing.
+type azureForge struct {
+	repo Repo
+}
+
+func (f *azureForge) Repo() Repo { return f.repo }
+
+func (f *azureForge) organizationURL() string {
+	if strings.HasSuffix(strings.ToLower(f.repo.Host), ".visualstudio.com") {
+		return "https://" + f.repo.Host
+	}
+	return "https://dev.azure.com/" + f.repo.Owner
+}
+
+func (f *azureForge) ListPullRequests(ctx context.Context, dir, branch string, limit int) ([]PullRequest, error) {
+	if branch == "" {
+		return nil, nil
+	}
+	out, err := runCLI(ctx, dir, "az", "repos", "pr", "list",
+		"--organization", f.organizationURL(),
+		"--project", f.repo.Project,
+		"--repository", f.repo.Name,
+		"--source-branch", branch,
+		"--status", "all",
+		"--top", strconv.Itoa(limit),
+		"--output", "json",
+	)
+	if err != nil {
+		return nil, err
+	}
+	return f.parsePullRequests(out)
+}
+
+func (f *azureForge) ListCIRuns(ctx context.Context, dir, sha string) ([]CIRun, error) {
+	return nil, nil
+}
+
+func (f *azureForge) parsePullRequests(data []byte) ([]PullRequest, error) {
+	var raw []struct {
+		PullRequestID int    `json:"pullRequestId"`
+		Status        string `json:"status"`
+		IsDraft       bool   `json:"isDraft"`
+		CreationDate  string `json:"creationDate"`
+		ClosedDate    string `json:"closedDate"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	prs := make([]PullRequest, 0, len(raw))
+	for _, pr := range raw {
+		updated, _ := time.Parse(time.RFC3339Nano, pr.ClosedDate)
+		if updated.IsZero() {
+			updated, _ = time.Parse(time.RFC3339Nano, pr.CreationDate)
+		}
+		state := "OPEN"
+		switch strings.ToLower(pr.Status) {
+		case "completed":
+			state = "MERGED"
+		case "abandoned":
+			state = "CLOSED"
+		}
+		prs = append(prs, PullRequest{
+			Number:    pr.PullRequestID,
+			State:     state,
+			IsDraft:   pr.IsDraft,
+			UpdatedAt: updated,
+			URL:       fmt.Sprintf("%s/%s/_git/%s/pullrequest/%d", f.organizationURL(), f.repo.Project, f.repo.Name, pr.PullRequestID),
+		})
+	}
+	return prs, nil
+}
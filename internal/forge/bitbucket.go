@@ -0,0 +1,102 @@
+package forge
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+const bitbucketAPIBase = "https://api.bitbucket.org/2.0"
+
+// bitbucketForge lists pull requests through the Bitbucket Cloud REST API.
+// Set BITBUCKET_TOKEN to an access token for private repositories. CI
+// (Pipelines) is not wired up yet, so ListCIRuns reports nothing.
+type bitbucketForge struct {
+	repo Repo
+}
+
+func (f *bitbucketForge) Repo() Repo { return f.repo }
+
+func (f *bitbucketForge) ListPullRequests(ctx context.Context, dir, branch string, limit int) ([]PullRequest, error) {
+	if branch == "" {
+		return nil, nil
+	}
+	query := url.Values{}
+	query.Set("q", fmt.Sprintf("source.branch.name=%q", branch))
+	query.Set("pagelen", fmt.Sprint(limit))
+	query.Set("sort", "-updated_on")
+	for _, state := range []string{"OPEN", "MERGED", "DECLINED", "SUPERSEDED"} {
+		query.Add("state", state)
+	}
+	endpoint := fmt.Sprintf("%s/repositories/%s/%s/pullrequests?%s",
+		bitbucketAPIBase, url.PathEscape(f.repo.Owner), url.PathEscape(f.repo.Name), query.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	if token := strings.TrimSpace(os.Getenv("BITBUCKET_TOKEN")); token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		return nil, fmt.Errorf("bitbucket pull requests: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("bitbucket pull requests: %s", resp.Status)
+	}
+	return parseBitbucketPullRequests(body)
+}
+
+func (f *bitbucketForge) ListCIRuns(ctx context.Context, dir, sha string) ([]CIRun, error) {
+	return nil, nil
+}
+
+func parseBitbucketPullRequests(data []byte) ([]PullRequest, error) {
+	var page struct {
+		Values []struct {
+			ID        int    `json:"id"`
+			State     string `json:"state"`
+			Draft     bool   `json:"draft"`
+			UpdatedOn string `json:"updated_on"`
+			Links     struct {
+				HTML struct {
+					Href string `json:"href"`
+				} `json:"html"`
+			} `json:"links"`
+		} `json:"values"`
+	}
+	if err := json.Unmarshal(data, &page); err != nil {
+		return nil, err
+	}
+	prs := make([]PullRequest, 0, len(page.Values))
+	for _, v := range page.Values {
+		t, _ := time.Parse(time.RFC3339Nano, v.UpdatedOn)
+		state := strings.ToUpper(v.State)
+		if state == "DECLINED" || state == "SUPERSEDED" {
+			state = "CLOSED"
+		}
+		prs = append(prs, PullRequest{
+			Number:    v.ID,
+			State:     state,
+			IsDraft:   v.Draft,
+			UpdatedAt: t,
+			URL:       v.Links.HTML.Href,
+		})
+	}
+	return prs, nil
+}
@@ -0,0 +1,162 @@
+package forge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Kind names a supported code-hosting service.
+type Kind string
+
+const (
+	GitHub      Kind = "github"
+	Bitbucket   Kind = "bitbucket"
+	AzureDevOps Kind = "azure-devops"
+)
+
+// Repo identifies a repository on a forge. Project is only used by Azure
+// DevOps, where repositories live inside an organization's project.
+type Repo struct {
+	Kind    Kind
+	Host    string
+	Owner   string
+	Project string
+	Name    string
+}
+
+// PullRequest is the forge-neutral view of a pull request. State uses
+// GitHub's vocabulary (OPEN, CLOSED, MERGED) so callers can stay agnostic.
+type PullRequest struct {
+	Number    int
+	State     string
+	IsDraft   bool
+	UpdatedAt time.Time
+	URL       string
+	// ReviewDecision uses GitHub's vocabulary (APPROVED, CHANGES_REQUESTED,
+	// REVIEW_REQUIRED) and is empty for forges that do not report one.
+	ReviewDecision string
+	// Mergeable (MERGEABLE, CONFLICTING, UNKNOWN) and MergeStateStatus
+	// (CLEAN, DIRTY, BLOCKED, BEHIND, ...) also use GitHub's vocabulary.
+	Mergeable        string
+	MergeStateStatus string
+}
+
+// CIRun is a single CI check or pipeline run attached to a commit.
+type CIRun struct {
+	Name       string
+	Status     string
+	Conclusion string
+	URL        string
+}
+
+// Forge is implemented once per hosting service. Adding a provider means
+// adding an implementation and a case in Detect.
+type Forge interface {
+	Repo() Repo
+	ListPullRequests(ctx context.Context, dir, branch string, limit int) ([]PullRequest, error)
+	ListCIRuns(ctx context.Context, dir, sha string) ([]CIRun, error)
+}
+
+// Detect parses a git remote URL and returns the forge that hosts it.
+func Detect(remoteURL string) (Forge, error) {
+	host, path, err := splitRemote(remoteURL)
+	if err != nil {
+		return nil, err
+	}
+	segments := splitPath(path)
+	lowerHost := strings.ToLower(host)
+	switch {
+	case lowerHost == "github.com":
+		if len(segments) < 2 {
+			return nil, fmt.Errorf("invalid GitHub remote: %s", remoteURL)
+		}
+		return &githubForge{repo: Repo{Kind: GitHub, Host: host, Owner: segments[0], Name: segments[1]}}, nil
+	case lowerHost == "bitbucket.org":
+		if len(segments) < 2 {
+			return nil, fmt.Errorf("invalid Bitbucket remote: %s", remoteURL)
+		}
+		return &bitbucketForge{repo: Repo{Kind: Bitbucket, Host: host, Owner: segments[0], Name: segments[1]}}, nil
+	case lowerHost == "dev.azure.com" || lowerHost == "ssh.dev.azure.com" || strings.HasSuffix(lowerHost, ".visualstudio.com"):
+		repo, err := parseAzureRepo(lowerHost, segments)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Azure DevOps remote: %s", remoteURL)
+		}
+		repo.Host = host
+		return &azureForge{repo: repo}, nil
+	default:
+		return nil, fmt.Errorf("remote host %s is not a supported forge", host)
+	}
+}
+
+// splitRemote separates a remote URL into host and repository path, accepting
+// scp-style (git@host:path), ssh://, and http(s):// forms.
+func splitRemote(raw string) (string, string, error) {
+	trimmed := strings.TrimSpace(raw)
+	if trimmed == "" {
+		return "", "", errors.New("empty remote URL")
+	}
+	trimmed = strings.TrimSuffix(trimmed, ".git")
+
+	switch {
+	case strings.HasPrefix(trimmed, "ssh://"), strings.HasPrefix(trimmed, "https://"), strings.HasPrefix(trimmed, "http://"):
+		u, err := url.Parse(trimmed)
+		if err != nil {
+			return "", "", err
+		}
+		return u.Hostname(), strings.TrimPrefix(u.Path, "/"), nil
+	case strings.Contains(trimmed, ":") && !strings.Contains(strings.SplitN(trimmed, ":", 2)[0], "/"):
+		parts := strings.SplitN(trimmed, ":", 2)
+		host := parts[0]
+		if at := strings.LastIndex(host, "@"); at >= 0 {
+			host = host[at+1:]
+		}
+		return host, parts[1], nil
+	default:
+		return "", "", fmt.Errorf("unsupported remote URL: %s", raw)
+	}
+}
+
+func splitPath(path string) []string {
+	var segments []string
+	for _, seg := range strings.Split(path, "/") {
+		if seg != "" {
+			segments = append(segments, seg)
+		}
+	}
+	return segments
+}
+
+// parseAzureRepo understands the three Azure DevOps remote shapes:
+//
+//	https://dev.azure.com/<org>/<project>/_git/<repo>
+//	git@ssh.dev.azure.com:v3/<org>/<project>/<repo>
+//	https://<org>.visualstudio.com/[DefaultCollection/]<project>/_git/<repo>
+func parseAzureRepo(host string, segments []string) (Repo, error) {
+	repo := Repo{Kind: AzureDevOps}
+	switch {
+	case host == "ssh.dev.azure.com":
+		if len(segments) != 4 || segments[0] != "v3" {
+			return repo, errors.New("unexpected path")
+		}
+		repo.Owner, repo.Project, repo.Name = segments[1], segments[2], segments[3]
+	case host == "dev.azure.com":
+		if len(segments) != 4 || segments[2] != "_git" {
+			return repo, errors.New("unexpected path")
+		}
+		repo.Owner, repo.Project, repo.Name = segments[0], segments[1], segments[3]
+	default:
+		if len(segments) > 0 && strings.EqualFold(segments[0], "DefaultCollection") {
+			segments = segments[1:]
+		}
+		if len(segments) != 3 || segments[1] != "_git" {
+			return repo, errors.New("unexpected path")
+		}
+		repo.Owner = strings.TrimSuffix(host, ".visualstudio.com")
+		repo.Project, repo.Name = segments[0], segments[2]
+	}
+	return repo, nil
+}
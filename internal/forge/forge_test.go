@@ -0,0 +1,62 @@
+package forge
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDetectParsesRemoteURLs(t *testing.T) {
+	cases := []struct {
+		url  string
+		want Repo
+	}{
+		{"git@github.com:brandonbloom/wt.git", Repo{Kind: GitHub, Host: "github.com", Owner: "brandonbloom", Name: "wt"}},
+		{"https://github.com/brandonbloom/wt", Repo{Kind: GitHub, Host: "github.com", Owner: "brandonbloom", Name: "wt"}},
+		{"ssh://git@github.com/brandonbloom/wt.git", Repo{Kind: GitHub, Host: "github.com", Owner: "brandonbloom", Name: "wt"}},
+		{"git@bitbucket.org:acme/widgets.git", Repo{Kind: Bitbucket, Host: "bitbucket.org", Owner: "acme", Name: "widgets"}},
+		{"https://jane@bitbucket.org/acme/widgets.git", Repo{Kind: Bitbucket, Host: "bitbucket.org", Owner: "acme", Name: "widgets"}},
+		{"https://dev.azure.com/contoso/Fabrikam/_git/web", Repo{Kind: AzureDevOps, Host: "dev.azure.com", Owner: "contoso", Project: "Fabrikam", Name: "web"}},
+		{"https://contoso@dev.azure.com/contoso/Fabrikam/_git/web", Repo{Kind: AzureDevOps, Host: "dev.azure.com", Owner: "contoso", Project: "Fabrikam", Name: "web"}},
+		{"git@ssh.dev.azure.com:v3/contoso/Fabrikam/web", Repo{Kind: AzureDevOps, Host: "ssh.dev.azure.com", Owner: "contoso", Project: "Fabrikam", Name: "web"}},
+		{"https://contoso.visualstudio.com/DefaultCollection/Fabrikam/_git/web", Repo{Kind: AzureDevOps, Host: "contoso.visualstudio.com", Owner: "contoso", Project: "Fabrikam", Name: "web"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.url, func(t *testing.T) {
+			f, err := Detect(tc.url)
+			if err != nil {
+				t.Fatalf("Detect: %v", err)
+			}
+			if got := f.Repo(); got != tc.want {
+				t.Fatalf("Repo() = %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDetectRejectsUnknownRemotes(t *testing.T) {
+	cases := map[string]string{
+		"../remote.git":                     "unsupported remote URL",
+		"git@gitlab.com:acme/widgets.git":   "not a supported forge",
+		"https://dev.azure.com/contoso/web": "invalid Azure DevOps remote",
+		"https://github.com/just-an-owner":  "invalid GitHub remote",
+	}
+	for url, want := range cases {
+		if _, err := Detect(url); err == nil || !strings.Contains(err.Error(), want) {
+			t.Fatalf("Detect(%q) error = %v, want %q", url, err, want)
+		}
+	}
+}
+
+func TestParseBitbucketPullRequestsMapsStates(t *testing.T) {
+	data := []byte(`{"values":[
+		{"id":4,"state":"OPEN","draft":true,"updated_on":"2024-03-14T15:09:26.123456+00:00","links":{"html":{"href":"https://bitbucket.org/acme/widgets/pull-requests/4"}}},
+		{"id":3,"state":"DECLINED","updated_on":"2024-03-01T00:00:00+00:00"}
+	]}`)
+	prs, err := parseBitbucketPullRequests(data)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if len(prs) != 2 || prs[0].Number != 4 || !prs[0].IsDraft || prs[0].UpdatedAt.IsZero() || prs[1].State != "CLOSED" {
+		t.Fatalf("unexpected pull requests: %+v", prs)
+	}
+}
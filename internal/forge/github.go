@@ -0,0 +1,122 @@
+package forge
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// githubForge talks to GitHub through the gh CLI, inheriting its auth and
+// host configuration. gh infers the repository from dir unless repo names
+// one.
+type githubForge struct {
+	repo Repo
+}
+
+// NewGitHub returns the gh-backed forge. A zero Repo is fine when callers
+// only need gh to infer the repository from the working directory.
+func NewGitHub(repo Repo) Forge {
+	repo.Kind = GitHub
+	return &githubForge{repo: repo}
+}
+
+func (f *githubForge) Repo() Repo { return f.repo }
+
+func (f *githubForge) ListPullRequests(ctx context.Context, dir, branch string, limit int) ([]PullRequest, error) {
+	if branch == "" {
+		return nil, nil
+	}
+	args := []string{"pr", "list",
+		"--head", branch,
+		"--state", "all",
+		"--limit", strconv.Itoa(limit),
+		"--json", "number,state,isDraft,updatedAt,url,reviewDecision,mergeable,mergeStateStatus",
+	}
+	args = append(args, f.repoArgs()...)
+	out, err := runCLI(ctx, dir, "gh", args...)
+	if err != nil {
+		return nil, err
+	}
+	var raw []struct {
+		Number           int    `json:"number"`
+		State            string `json:"state"`
+		IsDraft          bool   `json:"isDraft"`
+		UpdatedAt        string `json:"updatedAt"`
+		URL              string `json:"url"`
+		ReviewDecision   string `json:"reviewDecision"`
+		Mergeable        string `json:"mergeable"`
+		MergeStateStatus string `json:"mergeStateStatus"`
+	}
+	if err := json.Unmarshal(out, &raw); err != nil {
+		return nil, err
+	}
+	prs := make([]PullRequest, 0, len(raw))
+	for _, pr := range raw {
+		t, _ := time.Parse(time.RFC3339, pr.UpdatedAt)
+		prs = append(prs, PullRequest{
+			Number:           pr.Number,
+			State:            pr.State,
+			IsDraft:          pr.IsDraft,
+			UpdatedAt:        t,
+			URL:              pr.URL,
+			ReviewDecision:   pr.ReviewDecision,
+			Mergeable:        pr.Mergeable,
+			MergeStateStatus: pr.MergeStateStatus,
+		})
+	}
+	return prs, nil
+}
+
+// ListCIRuns reports the workflow runs for a commit. wt's status dashboard
+// uses a richer check-suite pipeline for GitHub; this keeps the interface
+// complete for callers that only need the basics.
+func (f *githubForge) ListCIRuns(ctx context.Context, dir, sha string) ([]CIRun, error) {
+	if sha == "" {
+		return nil, nil
+	}
+	args := append([]string{"run", "list", "--commit", sha, "--json", "name,status,conclusion,url"}, f.repoArgs()...)
+	out, err := runCLI(ctx, dir, "gh", args...)
+	if err != nil {
+		return nil, err
+	}
+	var runs []CIRun
+	if err := json.Unmarshal(out, &runs); err != nil {
+		return nil, err
+	}
+	return runs, nil
+}
+
+// repoArgs pins gh to the configured repository, so lookups follow the
+// chosen remote rather than gh's own guess (which prefers upstream remotes).
+func (f *githubForge) repoArgs() []string {
+	if f.repo.Owner == "" || f.repo.Name == "" {
+		return nil
+	}
+	return []string{"--repo", f.repo.Owner + "/" + f.repo.Name}
+}
+
+// runCLI runs a forge CLI and returns stdout, folding stderr into the error.
+// Context cancellation is reported as the context's error so callers can
+// distinguish timeouts from tool failures.
+func runCLI(ctx context.Context, dir, name string, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Dir = dir
+	var stdout, stderr strings.Builder
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		msg := strings.TrimSpace(stderr.String())
+		if msg == "" {
+			msg = err.Error()
+		}
+		return nil, fmt.Errorf("%s %s: %s", name, strings.Join(args[:min(2, len(args))], " "), msg)
+	}
+	return []byte(stdout.String()), nil
+}
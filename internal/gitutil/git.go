@@ -5,7 +5,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"net/url"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -62,76 +61,39 @@ func gitConfigGet(dir, key string) (string, bool, error) {
 	return strings.TrimSpace(stdout.String()), true, nil
 }
 
-// ParseGitHubRemote extracts owner/repo from a GitHub remote URL.
-func ParseGitHubRemote(raw string) (string, string, error) {
-	trimmed := strings.TrimSpace(raw)
-	if trimmed == "" {
-		return "", "", errors.New("empty remote URL")
-	}
-	trimmed = strings.TrimSuffix(trimmed, ".git")
-
-	var host, path string
-	switch {
-	case strings.HasPrefix(trimmed, "git@"):
-		parts := strings.SplitN(strings.TrimPrefix(trimmed, "git@"), ":", 2)
-		if len(parts) != 2 {
-			return "", "", fmt.Errorf("invalid ssh remote: %s", raw)
-		}
-		host = parts[0]
-		path = parts[1]
-	case strings.HasPrefix(trimmed, "ssh://"):
-		u, err := url.Parse(trimmed)
-		if err != nil {
-			return "", "", err
-		}
-		host = u.Hostname()
-		path = strings.TrimPrefix(u.Path, "/")
-	case strings.HasPrefix(trimmed, "https://") || strings.HasPrefix(trimmed, "http://"):
-		u, err := url.Parse(trimmed)
-		if err != nil {
-			return "", "", err
-		}
-		host = u.Hostname()
-		path = strings.TrimPrefix(u.Path, "/")
-	default:
-		// treat as bare <host>:<path>?
-		if strings.Contains(trimmed, ":") {
-			parts := strings.SplitN(trimmed, ":", 2)
-			host = parts[0]
-			path = parts[1]
-		} else {
-			return "", "", fmt.Errorf("unsupported remote URL: %s", raw)
-		}
-	}
-
-	if !strings.EqualFold(host, "github.com") {
-		return "", "", fmt.Errorf("remote host %s is not github.com", host)
-	}
+type StatusSummary struct {
+	Head    string
+	HeadOID string
+	Ahead   int
+	Behind  int
+	HasAB   bool
+	// Upstream is the configured upstream (e.g. origin/feature), empty when
+	// none is set.
+	Upstream string
+	// UpstreamGone is set when an upstream is configured but its ref no
+	// longer exists, which is what `git status -b` reports as [gone].
+	UpstreamGone bool
+	Paths        []string
+	HasChanges   bool
+	// SubmodulesOnly is set when every change is a submodule modification
+	// (new commits, modified or untracked content inside the submodule).
+	SubmodulesOnly bool
+}
 
-	segments := strings.Split(path, "/")
-	if len(segments) < 2 {
-		return "", "", fmt.Errorf("invalid GitHub remote path: %s", path)
-	}
-	owner := segments[0]
-	repo := segments[1]
-	if owner == "" || repo == "" {
-		return "", "", fmt.Errorf("invalid GitHub remote: %s", raw)
-	}
-	return owner, repo, nil
+func Status(dir string) (StatusSummary, error) {
+	return status(dir)
 }
 
-type StatusSummary struct {
-	Head       string
-	HeadOID    string
-	Ahead      int
-	Behind     int
-	HasAB      bool
-	Paths      []string
-	HasChanges bool
+// StatusIncludingSubmodules is like Status but passes
+// --ignore-submodules=none so submodule modifications count as changes even
+// when diff.ignoreSubmodules or submodule.<name>.ignore would hide them.
+func StatusIncludingSubmodules(dir string) (StatusSummary, error) {
+	return status(dir, "--ignore-submodules=none")
 }
 
-func Status(dir string) (StatusSummary, error) {
-	out, err := Run(dir, "status", "--porcelain=2", "--branch", "-z")
+func status(dir string, extra ...string) (StatusSummary, error) {
+	args := append([]string{"status", "--porcelain=2", "--branch", "-z"}, extra...)
+	out, err := Run(dir, args...)
 	if err != nil {
 		return StatusSummary{}, err
 	}
@@ -140,6 +102,7 @@ func Status(dir string) (StatusSummary, error) {
 	}
 
 	var status StatusSummary
+	fileChanges := 0
 	parts := strings.Split(out, "\x00")
 	for i := 0; i < len(parts); i++ {
 		rec := parts[i]
@@ -152,6 +115,8 @@ func Status(dir string) (StatusSummary, error) {
 				status.Head = strings.TrimSpace(strings.TrimPrefix(rec, "# branch.head "))
 			case strings.HasPrefix(rec, "# branch.oid "):
 				status.HeadOID = strings.TrimSpace(strings.TrimPrefix(rec, "# branch.oid "))
+			case strings.HasPrefix(rec, "# branch.upstream "):
+				status.Upstream = strings.TrimSpace(strings.TrimPrefix(rec, "# branch.upstream "))
 			case strings.HasPrefix(rec, "# branch.ab "):
 				var plus, minus int
 				_, scanErr := fmt.Sscanf(rec, "# branch.ab +%d -%d", &plus, &minus)
@@ -171,6 +136,11 @@ func Status(dir string) (StatusSummary, error) {
 				status.Paths = append(status.Paths, fields[len(fields)-1])
 				status.HasChanges = true
 			}
+			// The third field is the submodule state: "N..." for regular
+			// paths, "S<c><m><u>" for submodules.
+			if len(fields) < 3 || !strings.HasPrefix(fields[2], "S") {
+				fileChanges++
+			}
 			if rec[0] == '2' && i+1 < len(parts) {
 				// For renames, porcelain v2 encodes the original path as a separate NUL-delimited record.
 				i++
@@ -180,9 +150,12 @@ func Status(dir string) (StatusSummary, error) {
 			if path != "" {
 				status.Paths = append(status.Paths, path)
 				status.HasChanges = true
+				fileChanges++
 			}
 		}
 	}
+	status.SubmodulesOnly = status.HasChanges && fileChanges == 0
+	status.UpstreamGone = status.Upstream != "" && !status.HasAB
 
 	if status.Head == "(detached)" {
 		status.Head = "HEAD"
@@ -209,51 +182,48 @@ func Dirty(dir string) (bool, error) {
 	return status.HasChanges, nil
 }
 
-// HasBranchStash reports whether any stash entries mention the given branch.
+// HasBranchStash reports whether any stash entries were made on the given
+// branch.
 func HasBranchStash(dir, branch string) (bool, error) {
-	out, err := Run(dir, "stash", "list")
-	if err != nil {
-		return false, err
-	}
-	if strings.TrimSpace(out) == "" || branch == "" {
+	if branch == "" {
 		return false, nil
 	}
-	pattern := fmt.Sprintf("on %s:", branch)
-	for _, line := range strings.Split(out, "\n") {
-		if strings.Contains(line, pattern) {
-			return true, nil
-		}
+	branches, err := StashBranches(dir)
+	if err != nil {
+		return false, err
 	}
-	return false, nil
+	return branches[branch], nil
 }
 
-// StashBranches returns a set of branch names mentioned by stash entries.
+// StashBranches returns the set of branches stash entries were made on. The
+// stash is shared by every worktree of a repository, so dir may be any of
+// them.
 func StashBranches(dir string) (map[string]bool, error) {
-	out, err := Run(dir, "stash", "list")
+	out, err := Run(dir, "stash", "list", "--format=%gs")
 	if err != nil {
 		return nil, err
 	}
-	out = strings.TrimSpace(out)
-	if out == "" {
-		return map[string]bool{}, nil
-	}
+	return parseStashBranches(out), nil
+}
+
+// parseStashBranches reads stash reflog subjects: "WIP on <branch>: ..." for
+// a bare `git stash` and "On <branch>: ..." for `git stash push -m`.
+func parseStashBranches(out string) map[string]bool {
 	branches := make(map[string]bool)
 	for _, line := range strings.Split(out, "\n") {
-		line = strings.TrimSpace(line)
-		if line == "" {
+		line = strings.TrimPrefix(strings.TrimSpace(line), "WIP ")
+		rest, ok := strings.CutPrefix(line, "on ")
+		if !ok {
+			rest, ok = strings.CutPrefix(line, "On ")
+		}
+		if !ok {
 			continue
 		}
-		if idx := strings.Index(line, " on "); idx >= 0 {
-			rest := line[idx+len(" on "):]
-			if end := strings.Index(rest, ":"); end > 0 {
-				branch := strings.TrimSpace(rest[:end])
-				if branch != "" {
-					branches[branch] = true
-				}
-			}
+		if branch, _, found := strings.Cut(rest, ":"); found && branch != "" {
+			branches[branch] = true
 		}
 	}
-	return branches, nil
+	return branches
 }
 
 // AheadBehind counts commits relative to upstream. Missing upstream yields zeros.
@@ -315,12 +285,50 @@ func HeadTimestamp(dir string) (time.Time, error) {
 	return t, nil
 }
 
+// HeadExists reports whether HEAD resolves to a commit. It is false in a
+// freshly initialized repository whose branch is still unborn.
+func HeadExists(dir string) (bool, error) {
+	cmd := exec.Command("git", "-C", dir, "rev-parse", "--verify", "--quiet", "HEAD")
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && exitErr.ExitCode() == 1 {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// CommitExists reports whether rev resolves to a commit in the repository
+// containing dir.
+func CommitExists(dir, rev string) (bool, error) {
+	cmd := exec.Command("git", "-C", dir, "rev-parse", "--verify", "--quiet", rev+"^{commit}")
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && exitErr.ExitCode() == 1 {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// HeadSubject returns the subject line of the HEAD commit.
+func HeadSubject(dir string) (string, error) {
+	return Run(dir, "log", "-1", "--format=%s", "HEAD")
+}
+
 // HeadMergedInto reports whether HEAD is already an ancestor of the given ref.
 func HeadMergedInto(dir, ref string) (bool, error) {
+	return RevMergedInto(dir, "HEAD", ref)
+}
+
+// RevMergedInto reports whether rev is already an ancestor of the given ref.
+func RevMergedInto(dir, rev, ref string) (bool, error) {
 	if ref == "" {
 		return false, nil
 	}
-	cmd := exec.Command("git", "-C", dir, "merge-base", "--is-ancestor", "HEAD", ref)
+	cmd := exec.Command("git", "-C", dir, "merge-base", "--is-ancestor", rev, ref)
 	if err := cmd.Run(); err != nil {
 		var exitErr *exec.ExitError
 		if errors.As(err, &exitErr) && exitErr.ExitCode() == 1 {
@@ -388,6 +396,9 @@ func WorktreeOperation(dir string) (string, error) {
 	}{
 		{state: "rebasing", paths: []string{"rebase-merge", "rebase-apply"}},
 		{state: "merging", paths: []string{"MERGE_HEAD"}},
+		{state: "cherry-picking", paths: []string{"CHERRY_PICK_HEAD"}},
+		{state: "reverting", paths: []string{"REVERT_HEAD"}},
+		{state: "bisecting", paths: []string{"BISECT_LOG"}},
 	}
 	for _, check := range checks {
 		for _, rel := range check.paths {
@@ -409,6 +420,127 @@ func gitRefExists(dir, ref string) bool {
 	return cmd.Run() == nil
 }
 
+// RefExists reports whether the fully qualified ref (e.g. refs/heads/main) exists.
+func RefExists(dir, ref string) bool {
+	return gitRefExists(dir, ref)
+}
+
+// BranchExists reports whether a local branch with the given name exists.
+func BranchExists(dir, branch string) bool {
+	if strings.TrimSpace(branch) == "" {
+		return false
+	}
+	return gitRefExists(dir, "refs/heads/"+branch)
+}
+
+// BranchWorktreePath reports which worktree (if any) has branch checked out.
+func BranchWorktreePath(dir, branch string) (string, bool, error) {
+	entries, err := ListWorktrees(dir)
+	if err != nil {
+		return "", false, err
+	}
+	for _, entry := range entries {
+		if entry.Branch == branch {
+			return entry.Path, true, nil
+		}
+	}
+	return "", false, nil
+}
+
+// WorktreeEntry is one record from `git worktree list --porcelain`.
+type WorktreeEntry struct {
+	Path     string
+	Head     string
+	Branch   string // short name; empty when detached or bare
+	Bare     bool
+	Detached bool
+	Locked   bool
+	// Prunable is set when git considers the entry stale, typically because
+	// its directory was deleted without `git worktree remove`.
+	Prunable bool
+}
+
+// LocalBranches returns the short names of all local branches.
+func LocalBranches(dir string) ([]string, error) {
+	out, err := Run(dir, "for-each-ref", "--format=%(refname:short)", "refs/heads")
+	if err != nil {
+		return nil, err
+	}
+	var branches []string
+	for _, line := range strings.Split(out, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			branches = append(branches, line)
+		}
+	}
+	return branches, nil
+}
+
+// BranchUpstream is a local branch's configured upstream, e.g.
+// refs/remotes/origin/feature; Gone is set once that ref no longer exists.
+type BranchUpstream struct {
+	Ref  string
+	Gone bool
+}
+
+// BranchUpstreams maps each local branch that has an upstream configured to
+// that upstream.
+func BranchUpstreams(dir string) (map[string]BranchUpstream, error) {
+	out, err := Run(dir, "for-each-ref", "--format=%(refname:lstrip=2)%00%(upstream)%00%(upstream:track)", "refs/heads")
+	if err != nil {
+		return nil, err
+	}
+	upstreams := make(map[string]BranchUpstream)
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.Split(line, "\x00")
+		if len(fields) != 3 || fields[1] == "" {
+			continue
+		}
+		upstreams[fields[0]] = BranchUpstream{Ref: fields[1], Gone: fields[2] == "[gone]"}
+	}
+	return upstreams, nil
+}
+
+// ListWorktrees returns every worktree registered with the repository
+// containing dir, in git's order (the main worktree first).
+func ListWorktrees(dir string) ([]WorktreeEntry, error) {
+	out, err := Run(dir, "worktree", "list", "--porcelain")
+	if err != nil {
+		return nil, err
+	}
+	return parseWorktreeList(out), nil
+}
+
+func parseWorktreeList(out string) []WorktreeEntry {
+	var entries []WorktreeEntry
+	var current *WorktreeEntry
+	for _, line := range strings.Split(out, "\n") {
+		key, value, _ := strings.Cut(line, " ")
+		if key == "worktree" {
+			entries = append(entries, WorktreeEntry{Path: value})
+			current = &entries[len(entries)-1]
+			continue
+		}
+		if current == nil {
+			continue
+		}
+		switch key {
+		case "HEAD":
+			current.Head = value
+		case "branch":
+			current.Branch = strings.TrimPrefix(value, "refs/heads/")
+		case "bare":
+			current.Bare = true
+		case "detached":
+			current.Detached = true
+		case "locked":
+			current.Locked = true
+		case "prunable":
+			current.Prunable = true
+		}
+	}
+	return entries
+}
+
 func aheadBehindFromStatus(dir string) (ahead, behind int, ok bool, err error) {
 	status, err := Status(dir)
 	if err != nil {
@@ -450,7 +582,12 @@ func AheadBehindDefaultBranch(dir, defaultBranch string) (ahead, behind int, err
 	if !gitRefExists(dir, fullRef) {
 		return 0, 0, nil
 	}
-	return aheadBehindAgainstRef(dir, fmt.Sprintf("%s/%s", remote, defaultBranch))
+	return AheadBehindRef(dir, fmt.Sprintf("%s/%s", remote, defaultBranch))
+}
+
+// AheadBehindRef compares HEAD to an arbitrary ref.
+func AheadBehindRef(dir, ref string) (ahead, behind int, err error) {
+	return aheadBehindAgainstRef(dir, ref)
 }
 
 type DefaultBranchSyncMode string
@@ -537,6 +674,65 @@ func FetchRemoteDefaultBranch(ctx context.Context, dir, remote, defaultBranch st
 	return nil
 }
 
+// FetchRemote runs `git fetch --quiet <remote>`, refreshing every
+// remote-tracking branch the upstream ahead/behind counts rely on.
+func FetchRemote(ctx context.Context, dir, remote string) error {
+	return fetchRemote(ctx, dir, remote)
+}
+
+// FetchRemotePrune is FetchRemote with --prune, so remote-tracking branches
+// deleted on the remote are removed locally and their upstreams show as gone.
+func FetchRemotePrune(ctx context.Context, dir, remote string) error {
+	return fetchRemote(ctx, dir, remote, "--prune")
+}
+
+func fetchRemote(ctx context.Context, dir, remote string, flags ...string) error {
+	remote = strings.TrimSpace(remote)
+	if remote == "" {
+		remote = "origin"
+	}
+	args := append([]string{"-C", dir, "fetch", "--quiet"}, flags...)
+	cmd := exec.CommandContext(ctx, "git", append(args, remote)...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	cmd.Stdin = os.Stdin
+	if err := cmd.Run(); err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if msg == "" {
+			msg = err.Error()
+		}
+		return fmt.Errorf("git fetch %s: %s", remote, msg)
+	}
+	return nil
+}
+
+// LastFetchTime reports when dir's repository last fetched, from the mtime of
+// FETCH_HEAD in the worktree's git dir or the shared common dir, whichever is
+// newer. ok is false when the repository has never fetched.
+func LastFetchTime(dir string) (last time.Time, ok bool, err error) {
+	out, err := Run(dir, "rev-parse", "--git-dir", "--git-common-dir")
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	for _, gitDir := range strings.Split(strings.TrimSpace(out), "\n") {
+		gitDir = strings.TrimSpace(gitDir)
+		if gitDir == "" {
+			continue
+		}
+		if !filepath.IsAbs(gitDir) {
+			gitDir = filepath.Join(dir, gitDir)
+		}
+		info, statErr := os.Stat(filepath.Join(gitDir, "FETCH_HEAD"))
+		if statErr != nil {
+			continue
+		}
+		if mt := info.ModTime(); !ok || mt.After(last) {
+			last, ok = mt, true
+		}
+	}
+	return last, ok, nil
+}
+
 func aheadBehindAgainstRef(dir, ref string) (ahead, behind int, err error) {
 	out, err := Run(dir, "rev-list", "--left-right", "--count", ref+"...HEAD")
 	if err != nil {
@@ -557,6 +753,51 @@ func aheadBehindAgainstRef(dir, ref string) (ahead, behind int, err error) {
 	return ahead, behind, nil
 }
 
+// RemoteBranchName returns the name branch pushes to on remote, which differs
+// from branch when its upstream has another name and branch@{push} resolves
+// there (e.g. push.default=upstream). Otherwise the upstream is treated as a
+// shared branch the work merely started from (`git worktree add -b feat
+// ../feat origin/release`), and branch itself is returned. It also falls back
+// to branch when no upstream on remote is configured or it cannot be resolved
+// (e.g. the upstream is gone).
+func RemoteBranchName(dir, remote, branch string) string {
+	if remote == "" || branch == "" {
+		return branch
+	}
+	out, err := Run(dir, "rev-parse", "--abbrev-ref", branch+"@{upstream}")
+	if err != nil {
+		return branch
+	}
+	name, ok := strings.CutPrefix(strings.TrimSpace(out), remote+"/")
+	if !ok || name == "" || name == branch {
+		return branch
+	}
+	if push, err := Run(dir, "rev-parse", "--abbrev-ref", branch+"@{push}"); err == nil && strings.TrimSpace(push) == remote+"/"+name {
+		return name
+	}
+	return branch
+}
+
+// RemoteBranchTrackedBy returns the first local branch other than except
+// whose upstream is remote/name, or "" when no other branch tracks it.
+func RemoteBranchTrackedBy(dir, remote, name, except string) (string, error) {
+	upstreams, err := BranchUpstreams(dir)
+	if err != nil {
+		return "", err
+	}
+	ref := "refs/remotes/" + remote + "/" + name
+	var tracker string
+	for branch, upstream := range upstreams {
+		if branch == except || upstream.Ref != ref {
+			continue
+		}
+		if tracker == "" || branch < tracker {
+			tracker = branch
+		}
+	}
+	return tracker, nil
+}
+
 // RemoteBranchHead reports the current commit for remote/branch if it exists.
 func RemoteBranchHead(dir, remote, branch string) (string, bool, error) {
 	if remote == "" || branch == "" {
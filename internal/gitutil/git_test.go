@@ -42,3 +42,61 @@ func TestIsMissingUpstreamError(t *testing.T) {
 		})
 	}
 }
+
+func TestParseWorktreeList(t *testing.T) {
+	out := `worktree /repo/.bare
+bare
+
+worktree /repo/main
+HEAD 1111111111111111111111111111111111111111
+branch refs/heads/main
+
+worktree /elsewhere/feature
+HEAD 2222222222222222222222222222222222222222
+branch refs/heads/feature/x
+locked on a removable disk
+
+worktree /repo/scratch
+HEAD 3333333333333333333333333333333333333333
+detached
+
+worktree /repo/gone
+HEAD 4444444444444444444444444444444444444444
+branch refs/heads/gone
+prunable gitdir file points to non-existent location
+`
+	got := parseWorktreeList(out)
+	want := []WorktreeEntry{
+		{Path: "/repo/.bare", Bare: true},
+		{Path: "/repo/main", Head: "1111111111111111111111111111111111111111", Branch: "main"},
+		{Path: "/elsewhere/feature", Head: "2222222222222222222222222222222222222222", Branch: "feature/x", Locked: true},
+		{Path: "/repo/scratch", Head: "3333333333333333333333333333333333333333", Detached: true},
+		{Path: "/repo/gone", Head: "4444444444444444444444444444444444444444", Branch: "gone", Prunable: true},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d entries, want %d: %+v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("entry %d: got %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestParseStashBranches(t *testing.T) {
+	out := `WIP on feature/x: 1111111 add widget
+On alpha: before rebase: keep this
+WIP on main: 2222222 on other: not a branch
+autostash
+`
+	got := parseStashBranches(out)
+	want := map[string]bool{"feature/x": true, "alpha": true, "main": true}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for branch := range want {
+		if !got[branch] {
+			t.Fatalf("missing %s in %v", branch, got)
+		}
+	}
+}
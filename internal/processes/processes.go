@@ -5,6 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
+	"time"
 )
 
 var (
@@ -19,13 +22,52 @@ type Process struct {
 	Command string `json:"command"`
 	CWD     string `json:"cwd"`
 	PPID    int    `json:"ppid"`
+	// StartedAt is when the process started; zero when the platform could
+	// not report it.
+	StartedAt time.Time `json:"started_at,omitzero"`
+	// State is the scheduler state, e.g. "sleeping", "stopped", or "zombie";
+	// empty when the platform could not report it.
+	State string `json:"state,omitempty"`
 }
 
 func List() ([]Process, error) {
 	if procs, ok, err := fromTestData(); err != nil || ok {
 		return procs, err
 	}
-	return listNative(os.Getuid())
+	return listNative(os.Getuid(), nil)
+}
+
+// ListUnder is like List but only reports processes whose working directory
+// is one of roots or beneath one. Roots should be absolute and already have
+// symlinks resolved, since the kernel reports resolved paths. The cwd is
+// checked before any other per-process metadata is read, so unrelated
+// processes cost little. Test data is returned unfiltered.
+func ListUnder(roots []string) ([]Process, error) {
+	if procs, ok, err := fromTestData(); err != nil || ok {
+		return procs, err
+	}
+	cleaned := make([]string, 0, len(roots))
+	for _, root := range roots {
+		if root != "" {
+			cleaned = append(cleaned, filepath.Clean(root))
+		}
+	}
+	if len(cleaned) == 0 {
+		return nil, nil
+	}
+	return listNative(os.Getuid(), func(cwd string) bool {
+		return underAnyRoot(cwd, cleaned)
+	})
+}
+
+func underAnyRoot(cwd string, roots []string) bool {
+	for _, root := range roots {
+		prefix := strings.TrimSuffix(root, string(filepath.Separator)) + string(filepath.Separator)
+		if cwd == root || strings.HasPrefix(cwd, prefix) {
+			return true
+		}
+	}
+	return false
 }
 
 // TestDataFilePath reports the file path used for WT_PROCESS_TEST_DATA_FILE, if any.
@@ -10,16 +10,25 @@ import "C"
 import (
 	"fmt"
 	"syscall"
+	"time"
 	"unsafe"
 )
 
-func listNative(uid int) ([]Process, error) {
+// listNative lists processes owned by uid. The BSD info lookup that carries
+// the uid is cheap, so owned pids are collected first and only they get the
+// per-pid cwd lookup; when keep is non-nil, processes whose cwd it rejects are
+// dropped before anything else is decoded.
+func listNative(uid int, keep func(cwd string) bool) ([]Process, error) {
 	pids, err := listAllPIDs()
 	if err != nil {
 		return nil, err
 	}
 
-	procs := make([]Process, 0, len(pids))
+	type owned struct {
+		pid  int32
+		info *C.struct_proc_bsdinfo
+	}
+	candidates := make([]owned, 0, len(pids))
 	for _, pid := range pids {
 		if pid <= 0 {
 			continue
@@ -31,22 +40,42 @@ func listNative(uid int) ([]Process, error) {
 		if info == nil || int(info.pbi_uid) != uid {
 			continue
 		}
+		candidates = append(candidates, owned{pid: pid, info: info})
+	}
+
+	procs := make([]Process, 0, len(candidates))
+	for _, cand := range candidates {
+		pid, info := cand.pid, cand.info
 		cwd, err := processCWD(int(pid))
 		if err != nil || cwd == "" {
 			continue
 		}
+		if keep != nil && !keep(cwd) {
+			continue
+		}
 		command := C.GoString(&info.pbi_comm[0])
 		command = sanitizeCommand(command, int(pid))
 		procs = append(procs, Process{
-			PID:     int(pid),
-			PPID:    int(info.pbi_ppid),
-			Command: command,
-			CWD:     cwd,
+			PID:       int(pid),
+			PPID:      int(info.pbi_ppid),
+			Command:   command,
+			CWD:       cwd,
+			StartedAt: time.Unix(int64(info.pbi_start_tvsec), int64(info.pbi_start_tvusec)*1000),
+			State:     bsdStates[int(info.pbi_status)],
 		})
 	}
 	return procs, nil
 }
 
+// bsdStates names the p_stat values from <sys/proc.h>.
+var bsdStates = map[int]string{
+	1: "idle",
+	2: "running",
+	3: "sleeping",
+	4: "stopped",
+	5: "zombie",
+}
+
 func processCWD(pid int) (string, error) {
 	var info C.struct_proc_vnodepathinfo
 	size := C.int(unsafe.Sizeof(info))
@@ -10,9 +10,13 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 )
 
-func listNative(uid int) ([]Process, error) {
+// listNative scans /proc for processes owned by uid. When keep is non-nil,
+// processes whose cwd it rejects are skipped before their status, comm, or
+// stat files are read.
+func listNative(uid int, keep func(cwd string) bool) ([]Process, error) {
 	entries, err := os.ReadDir("/proc")
 	if err != nil {
 		if os.IsNotExist(err) {
@@ -21,22 +25,27 @@ func listNative(uid int) ([]Process, error) {
 		return nil, err
 	}
 
+	bootTime, bootErr := readBootTime()
+
 	procs := make([]Process, 0, len(entries))
 	for _, entry := range entries {
 		pid, err := strconv.Atoi(entry.Name())
 		if err != nil {
 			continue
 		}
-		meta, err := readProcMetadata(entry.Name())
-		if err != nil || meta == nil || meta.uid != uid {
-			continue
-		}
-
 		cwd, err := os.Readlink(filepath.Join("/proc", entry.Name(), "cwd"))
 		if err != nil || cwd == "" {
 			continue
 		}
 		cwd = strings.TrimSuffix(cwd, " (deleted)")
+		if keep != nil && !keep(cwd) {
+			continue
+		}
+
+		meta, err := readProcMetadata(entry.Name())
+		if err != nil || meta == nil || meta.uid != uid {
+			continue
+		}
 
 		command, err := os.ReadFile(filepath.Join("/proc", entry.Name(), "comm"))
 		if err != nil || len(command) == 0 {
@@ -48,20 +57,74 @@ func listNative(uid int) ([]Process, error) {
 		cmd := strings.TrimSpace(string(command))
 		cmd = sanitizeCommand(cmd, pid)
 
+		var startedAt time.Time
+		if bootErr == nil {
+			if ticks, err := readStartTicks(entry.Name()); err == nil {
+				startedAt = bootTime.Add(time.Duration(ticks) * time.Second / clockTicksPerSecond)
+			}
+		}
+
 		procs = append(procs, Process{
-			PID:     pid,
-			PPID:    meta.ppid,
-			Command: cmd,
-			CWD:     cwd,
+			PID:       pid,
+			PPID:      meta.ppid,
+			Command:   cmd,
+			CWD:       cwd,
+			StartedAt: startedAt,
+			State:     meta.state,
 		})
 	}
 
 	return procs, nil
 }
 
+// clockTicksPerSecond is USER_HZ, which the kernel fixes at 100 for every
+// value it exports to userspace in clock ticks.
+const clockTicksPerSecond = 100
+
+// readBootTime reads the btime line of /proc/stat, the boot time in seconds
+// since the epoch that /proc/<pid>/stat start times are relative to.
+func readBootTime() (time.Time, error) {
+	data, err := os.ReadFile("/proc/stat")
+	if err != nil {
+		return time.Time{}, err
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if rest, ok := strings.CutPrefix(line, "btime "); ok {
+			secs, err := strconv.ParseInt(strings.TrimSpace(rest), 10, 64)
+			if err != nil {
+				return time.Time{}, err
+			}
+			return time.Unix(secs, 0), nil
+		}
+	}
+	return time.Time{}, errors.New("btime missing from /proc/stat")
+}
+
+// readStartTicks returns field 22 of /proc/<pid>/stat, the start time in
+// clock ticks since boot. The command name (field 2) may contain spaces and
+// parentheses, so fields are counted from the last ')'.
+func readStartTicks(pid string) (uint64, error) {
+	data, err := os.ReadFile(filepath.Join("/proc", pid, "stat"))
+	if err != nil {
+		return 0, err
+	}
+	stat := string(data)
+	end := strings.LastIndexByte(stat, ')')
+	if end < 0 {
+		return 0, errors.New("malformed stat")
+	}
+	fields := strings.Fields(stat[end+1:])
+	// fields[0] is field 3 (state), so field 22 is fields[19].
+	if len(fields) < 20 {
+		return 0, errors.New("short stat")
+	}
+	return strconv.ParseUint(fields[19], 10, 64)
+}
+
 type procMetadata struct {
 	uid     int
 	ppid    int
+	state   string
 	hasUID  bool
 	hasPPID bool
 }
@@ -87,6 +150,13 @@ func readProcMetadata(pid string) (*procMetadata, error) {
 					meta.hasUID = true
 				}
 			}
+		case strings.HasPrefix(line, "State:"):
+			// "State:\tS (sleeping)"; keep the spelled-out name.
+			value := strings.TrimSpace(strings.TrimPrefix(line, "State:"))
+			if lp, rp := strings.IndexByte(value, '('), strings.LastIndexByte(value, ')'); lp >= 0 && rp > lp {
+				value = value[lp+1 : rp]
+			}
+			meta.state = value
 		case strings.HasPrefix(line, "PPid:"):
 			fields := strings.Fields(line)
 			if len(fields) >= 2 {
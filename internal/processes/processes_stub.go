@@ -2,6 +2,6 @@
 
 package processes
 
-func listNative(int) ([]Process, error) {
+func listNative(int, func(string) bool) ([]Process, error) {
 	return nil, ErrUnsupported
 }
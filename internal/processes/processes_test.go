@@ -0,0 +1,74 @@
+package processes
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestUnderAnyRoot(t *testing.T) {
+	roots := []string{"/a/foo", "/b"}
+	cases := []struct {
+		cwd  string
+		want bool
+	}{
+		{"/a/foo", true},
+		{"/a/foo/src", true},
+		{"/a/foo/src/deep", true},
+		{"/a/foobar", false},
+		{"/a/foobar/src", false},
+		{"/a", false},
+		{"/b/x", true},
+		{"/c", false},
+	}
+	for _, tc := range cases {
+		if got := underAnyRoot(tc.cwd, roots); got != tc.want {
+			t.Errorf("underAnyRoot(%q) = %v, want %v", tc.cwd, got, tc.want)
+		}
+	}
+	if !underAnyRoot("/anything", []string{"/"}) {
+		t.Error("underAnyRoot with root / rejected /anything")
+	}
+}
+
+func TestListUnderFiltersBySiblingPrefix(t *testing.T) {
+	t.Setenv(testDataFileEnv, "")
+	t.Setenv(testDataInlineEnv, "")
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	wd, err = filepath.EvalSymlinks(wd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hasSelf := func(roots ...string) bool {
+		t.Helper()
+		procs, err := ListUnder(roots)
+		if errors.Is(err, ErrUnsupported) {
+			t.Skip("process detection unsupported")
+		}
+		if err != nil {
+			t.Fatalf("ListUnder(%q): %v", roots, err)
+		}
+		for _, proc := range procs {
+			if proc.PID == os.Getpid() {
+				return true
+			}
+		}
+		return false
+	}
+	if !hasSelf(wd) {
+		t.Fatalf("ListUnder(%q) missed this process", wd)
+	}
+	if !hasSelf(filepath.Dir(wd) + string(filepath.Separator)) {
+		t.Fatalf("ListUnder(parent of %q) missed this process", wd)
+	}
+	if hasSelf(wd + "bar") {
+		t.Fatalf("ListUnder(%q) matched a process in sibling %q", wd+"bar", wd)
+	}
+	if procs, err := ListUnder(nil); err != nil || procs != nil {
+		t.Fatalf("ListUnder(nil) = %v, %v; want nothing", procs, err)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"sort"
 
 	"github.com/brandonbloom/wt/internal/config"
+	"github.com/brandonbloom/wt/internal/gitutil"
 )
 
 var (
@@ -59,6 +60,19 @@ func Load(root string) (*Project, error) {
 	}, nil
 }
 
+// LocateRoot returns the project root containing start without loading the
+// config, for diagnosing projects whose config does not load.
+func LocateRoot(start string) (string, error) {
+	return locateRoot(start)
+}
+
+// DefaultWorktreeName returns the default worktree directory (main or master)
+// under root.
+func DefaultWorktreeName(root string) (string, error) {
+	name, _, err := resolveDefaultWorktree(root)
+	return name, err
+}
+
 func locateRoot(start string) (string, error) {
 	cur, err := filepath.Abs(start)
 	if err != nil {
@@ -122,15 +136,31 @@ func isWorktree(path string) bool {
 type Worktree struct {
 	Name string
 	Path string
+	// Branch is the checked-out branch as reported by git; empty when
+	// detached or when git does not list the worktree.
+	Branch string
+	// Locked reports `git worktree lock`, which tidy respects.
+	Locked bool
+	// Main marks the repository's main working tree, which git refuses to
+	// remove. It is only distinct from the default worktree when that one was
+	// created with `git worktree add` (its .git is a gitdir file).
+	Main bool
+	// Missing marks a worktree git still has registered whose directory no
+	// longer exists. Only MissingWorktrees reports these.
+	Missing bool
 }
 
-// ListWorktrees enumerates all git worktrees immediately under the root.
+// ListWorktrees enumerates the project's worktrees. Immediate children of the
+// root that look like worktrees are reconciled with `git worktree list
+// --porcelain`, which also contributes worktrees that live elsewhere (e.g.
+// after `wt move`).
 func ListWorktrees(root string) ([]Worktree, error) {
 	entries, err := os.ReadDir(root)
 	if err != nil {
 		return nil, err
 	}
 	var result []Worktree
+	names := make(map[string]bool)
 	for _, entry := range entries {
 		if !entry.IsDir() {
 			continue
@@ -144,11 +174,100 @@ func ListWorktrees(root string) ([]Worktree, error) {
 			continue
 		}
 		result = append(result, Worktree{Name: name, Path: path})
+		names[name] = true
 	}
+	result = reconcileGitWorktrees(root, result, names)
 	sortWorktrees(result)
 	return result, nil
 }
 
+// reconcileGitWorktrees fills in branches for scanned worktrees and appends
+// registered worktrees outside the directory scan. Scanned paths are kept as
+// joined under root (git reports symlink-resolved paths), and external entries
+// whose basename collides with an existing name are skipped so name lookups
+// stay unambiguous. When git cannot be queried the scan stands alone.
+func reconcileGitWorktrees(root string, scanned []Worktree, names map[string]bool) []Worktree {
+	_, defaultPath, err := resolveDefaultWorktree(root)
+	if err != nil {
+		return scanned
+	}
+	entries, err := gitutil.ListWorktrees(defaultPath)
+	if err != nil {
+		return scanned
+	}
+	byPath := make(map[string]int, len(scanned))
+	for i, wt := range scanned {
+		byPath[realPath(wt.Path)] = i
+	}
+	for i, entry := range entries {
+		if entry.Bare {
+			continue
+		}
+		// git always lists the main working tree (or the bare repository) first.
+		main := i == 0
+		if j, ok := byPath[realPath(entry.Path)]; ok {
+			scanned[j].Branch = entry.Branch
+			scanned[j].Locked = entry.Locked
+			scanned[j].Main = main
+			continue
+		}
+		if !isWorktree(entry.Path) {
+			continue
+		}
+		name := filepath.Base(entry.Path)
+		if names[name] {
+			continue
+		}
+		names[name] = true
+		scanned = append(scanned, Worktree{Name: name, Path: entry.Path, Branch: entry.Branch, Locked: entry.Locked, Main: main})
+	}
+	return scanned
+}
+
+// MissingWorktrees returns the worktrees git still has registered but whose
+// directories were removed out-of-band, which `git worktree list` marks
+// prunable. ListWorktrees leaves them out; entries whose name collides with
+// one of listed are skipped. When git cannot be queried there is nothing to
+// report.
+func MissingWorktrees(root string, listed []Worktree) []Worktree {
+	_, defaultPath, err := resolveDefaultWorktree(root)
+	if err != nil {
+		return nil
+	}
+	entries, err := gitutil.ListWorktrees(defaultPath)
+	if err != nil {
+		return nil
+	}
+	names := make(map[string]bool, len(listed))
+	for _, wt := range listed {
+		names[wt.Name] = true
+	}
+	var missing []Worktree
+	for _, entry := range entries {
+		if entry.Bare || !entry.Prunable {
+			continue
+		}
+		if _, err := os.Stat(entry.Path); !errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		name := filepath.Base(entry.Path)
+		if names[name] {
+			continue
+		}
+		names[name] = true
+		missing = append(missing, Worktree{Name: name, Path: entry.Path, Branch: entry.Branch, Locked: entry.Locked, Missing: true})
+	}
+	sortWorktrees(missing)
+	return missing
+}
+
+func realPath(path string) string {
+	if resolved, err := filepath.EvalSymlinks(path); err == nil {
+		return resolved
+	}
+	return filepath.Clean(path)
+}
+
 func sortWorktrees(wts []Worktree) {
 	sort.Slice(wts, func(i, j int) bool {
 		return wts[i].Name < wts[j].Name
@@ -56,6 +56,22 @@ func Relative(t, reference time.Time) string {
 	return t.Format("Jan 2 2006")
 }
 
+// AbsoluteLayout is the format Absolute renders, minute precision.
+const AbsoluteLayout = "2006-01-02 15:04"
+
+// Absolute renders t as a timestamp in reference's location (the local zone
+// when reference is zero).
+func Absolute(t, reference time.Time) string {
+	if t.IsZero() {
+		return "unknown"
+	}
+	loc := time.Local
+	if !reference.IsZero() {
+		loc = reference.Location()
+	}
+	return t.In(loc).Format(AbsoluteLayout)
+}
+
 func sameDay(a, b time.Time) bool {
 	ay, am, ad := a.Date()
 	by, bm, bd := b.Date()
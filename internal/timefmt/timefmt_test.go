@@ -33,3 +33,15 @@ func TestRelative(t *testing.T) {
 		})
 	}
 }
+
+func TestAbsolute(t *testing.T) {
+	loc := time.FixedZone("Test", -8*3600)
+	ref := time.Date(2025, time.December, 5, 15, 0, 0, 0, loc)
+
+	if got, want := Absolute(time.Date(2025, time.December, 5, 20, 7, 30, 0, time.UTC), ref), "2025-12-05 12:07"; got != want {
+		t.Fatalf("Absolute = %q, want %q", got, want)
+	}
+	if got := Absolute(time.Time{}, ref); got != "unknown" {
+		t.Fatalf("Absolute(zero) = %q, want %q", got, "unknown")
+	}
+}
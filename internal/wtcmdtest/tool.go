@@ -303,6 +303,8 @@ func deterministicEnv(base []string) []string {
 	env["NO_COLOR"] = "1"
 	env["CLICOLOR"] = "0"
 	env["CLICOLOR_FORCE"] = "0"
+	// Keep transcripts free of OSC 8 links regardless of the host terminal.
+	env["WT_HYPERLINKS"] = "0"
 	return envSlice(env)
 }
 
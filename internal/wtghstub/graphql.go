@@ -40,12 +40,15 @@ func handleGraphQL(stateFile string, args []string) (string, error) {
 	}
 
 	type gqlPRNode struct {
-		Number      int    `json:"number"`
-		State       string `json:"state"`
-		IsDraft     bool   `json:"isDraft"`
-		UpdatedAt   string `json:"updatedAt"`
-		URL         string `json:"url"`
-		HeadRefName string `json:"headRefName"`
+		Number           int    `json:"number"`
+		State            string `json:"state"`
+		IsDraft          bool   `json:"isDraft"`
+		UpdatedAt        string `json:"updatedAt"`
+		URL              string `json:"url"`
+		HeadRefName      string `json:"headRefName"`
+		ReviewDecision   string `json:"reviewDecision,omitempty"`
+		Mergeable        string `json:"mergeable,omitempty"`
+		MergeStateStatus string `json:"mergeStateStatus,omitempty"`
 	}
 	type gqlPRConn struct {
 		Nodes []gqlPRNode `json:"nodes"`
@@ -67,12 +70,15 @@ func handleGraphQL(stateFile string, args []string) (string, error) {
 				continue
 			}
 			nodes = append(nodes, gqlPRNode{
-				Number:      pr.Number,
-				State:       pr.State,
-				IsDraft:     pr.IsDraft,
-				UpdatedAt:   pr.UpdatedAt,
-				URL:         pr.URL,
-				HeadRefName: pr.Branch,
+				Number:           pr.Number,
+				State:            pr.State,
+				IsDraft:          pr.IsDraft,
+				UpdatedAt:        pr.UpdatedAt,
+				URL:              pr.URL,
+				HeadRefName:      pr.Branch,
+				ReviewDecision:   pr.ReviewDecision,
+				Mergeable:        pr.Mergeable,
+				MergeStateStatus: pr.MergeStateStatus,
 			})
 			if len(nodes) >= 5 {
 				break
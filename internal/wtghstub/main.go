@@ -1,9 +1,10 @@
 // wtghstub is a hermetic stub for the `gh` CLI used by transcript tests.
 //
 // Supported subcommands:
-//   - `gh auth status` (always succeeds)
+//   - `gh auth status` (succeeds unless WT_GH_UNAUTHENTICATED is set, which
+//     makes every command fail like a logged-out gh)
 //   - `gh repo view` (prints "main")
-//   - `gh pr list` / `gh pr close`
+//   - `gh pr list` / `gh pr close` / `gh pr create`
 //   - `gh api graphql`, `gh api repos/.../commits/.../check-runs`, `gh api repos/.../actions/runs...`
 //   - `gh run list` (prints "[]")
 //
@@ -30,6 +31,11 @@ func main() {
 	sub := os.Args[1]
 	args := os.Args[2:]
 
+	if os.Getenv("WT_GH_UNAUTHENTICATED") != "" {
+		fmt.Fprintln(os.Stderr, "To get started with GitHub CLI, please run:  gh auth login")
+		os.Exit(4)
+	}
+
 	switch sub {
 	case "auth":
 		if len(args) >= 1 && args[0] == "status" {
@@ -51,6 +57,15 @@ func main() {
 			}
 			os.Exit(code)
 		}
+		if len(args) >= 1 && args[0] == "create" {
+			out, err := handlePRCreate(stateFile, args[1:])
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+			fmt.Fprintln(os.Stdout, out)
+			os.Exit(0)
+		}
 		if len(args) >= 1 && args[0] == "close" {
 			out, err := handlePRClose(stateFile, args[1:])
 			if err != nil {
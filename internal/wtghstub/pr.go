@@ -2,7 +2,7 @@
 //
 // Backed by `WT_GH_STATE_FILE` (pipe-delimited records):
 //
-//	branch|number|state|isDraft|updatedAt|url
+//	branch|number|state|isDraft|updatedAt|url[|reviewDecision[|mergeable[|mergeStateStatus]]]
 package main
 
 import (
@@ -16,12 +16,15 @@ import (
 )
 
 type prRecord struct {
-	Branch    string
-	Number    int
-	State     string
-	IsDraft   bool
-	UpdatedAt string
-	URL       string
+	Branch           string
+	Number           int
+	State            string
+	IsDraft          bool
+	UpdatedAt        string
+	URL              string
+	ReviewDecision   string
+	Mergeable        string
+	MergeStateStatus string
 }
 
 func handlePRList(stateFile string, args []string) (string, int) {
@@ -58,11 +61,14 @@ func handlePRList(stateFile string, args []string) (string, int) {
 			continue
 		}
 		out = append(out, map[string]any{
-			"number":    pr.Number,
-			"state":     pr.State,
-			"isDraft":   pr.IsDraft,
-			"updatedAt": pr.UpdatedAt,
-			"url":       pr.URL,
+			"number":           pr.Number,
+			"state":            pr.State,
+			"isDraft":          pr.IsDraft,
+			"updatedAt":        pr.UpdatedAt,
+			"url":              pr.URL,
+			"reviewDecision":   pr.ReviewDecision,
+			"mergeable":        pr.Mergeable,
+			"mergeStateStatus": pr.MergeStateStatus,
 		})
 		if limit > 0 && len(out) >= limit {
 			break
@@ -73,6 +79,54 @@ func handlePRList(stateFile string, args []string) (string, int) {
 	return string(b), 0
 }
 
+// handlePRCreate appends an OPEN record for --head and prints its URL, like
+// `gh pr create`. The timestamp comes from WT_NOW so transcripts stay stable.
+func handlePRCreate(stateFile string, args []string) (string, error) {
+	branch := ""
+	draft := false
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--head":
+			if i+1 >= len(args) {
+				return "", errors.New("gh stub: --head requires a branch")
+			}
+			branch = args[i+1]
+			i++
+		case "--draft":
+			draft = true
+		}
+	}
+	if branch == "" {
+		return "", errors.New("gh stub: pr create requires --head")
+	}
+
+	prs := loadPRs(stateFile)
+	number := 1
+	for _, pr := range prs {
+		if pr.Branch == branch && pr.State == "OPEN" {
+			return "", fmt.Errorf("a pull request for branch %q already exists:\n%s", branch, pr.URL)
+		}
+		if pr.Number >= number {
+			number = pr.Number + 1
+		}
+	}
+	updatedAt := os.Getenv("WT_NOW")
+	if updatedAt == "" {
+		updatedAt = "2000-01-01T00:00:00Z"
+	}
+	url := fmt.Sprintf("https://example.com/pr/%d", number)
+	record := fmt.Sprintf("%s|%d|OPEN|%t|%s|%s\n", branch, number, draft, updatedAt, url)
+	f, err := os.OpenFile(stateFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	if _, err := f.WriteString(record); err != nil {
+		return "", err
+	}
+	return url, nil
+}
+
 func handlePRClose(stateFile string, args []string) (string, error) {
 	if len(args) < 1 {
 		return "", errors.New("gh stub: pr close requires a number")
@@ -132,13 +186,26 @@ func loadPRs(path string) []prRecord {
 			continue
 		}
 		isDraft := parts[3] == "true"
+		review, mergeable, mergeState := "", "", ""
+		if len(parts) > 6 {
+			review = parts[6]
+		}
+		if len(parts) > 7 {
+			mergeable = parts[7]
+		}
+		if len(parts) > 8 {
+			mergeState = parts[8]
+		}
 		out = append(out, prRecord{
-			Branch:    parts[0],
-			Number:    n,
-			State:     parts[2],
-			IsDraft:   isDraft,
-			UpdatedAt: parts[4],
-			URL:       parts[5],
+			Branch:           parts[0],
+			Number:           n,
+			State:            parts[2],
+			IsDraft:          isDraft,
+			UpdatedAt:        parts[4],
+			URL:              parts[5],
+			ReviewDecision:   review,
+			Mergeable:        mergeable,
+			MergeStateStatus: mergeState,
 		})
 	}
 	return out